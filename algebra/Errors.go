@@ -0,0 +1,13 @@
+package algebra
+
+import "fmt"
+
+// SyntaxError reports where text could not be parsed; Position is the 1-based character column
+type SyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("algebra: %s at position %d", e.Message, e.Position)
+}
@@ -5,20 +5,19 @@ import (
 	"mymath/basicmath"
 	"mymath/datastructures"
 	"sort"
-	"strconv"
 	"strings"
 	"unicode"
 )
 
 type Monomial struct {
-	coefficient *basicmath.Fraction
+	coefficient basicmath.Rational  // a *Fraction, or a *BigFraction once it no longer fits in an int
 	variables   []*Variable         // Example: (x^2)(y^3)
 	degree      *basicmath.Fraction // Total degree of the term
 }
 
 // #region Monomial Constructors
 
-func NewMonomial(coefficient *basicmath.Fraction, letter string) *Monomial {
+func NewMonomial(coefficient basicmath.Rational, letter string) *Monomial {
 	m := &Monomial{
 		coefficient: coefficient,
 		variables:   []*Variable{NewVariableWithExponent(letter, basicmath.NewInteger(1))},
@@ -29,7 +28,7 @@ func NewMonomial(coefficient *basicmath.Fraction, letter string) *Monomial {
 	return m
 }
 
-func NewMonomialConstant(coefficient *basicmath.Fraction) *Monomial {
+func NewMonomialConstant(coefficient basicmath.Rational) *Monomial {
 	m := &Monomial{
 		coefficient: coefficient,
 	}
@@ -39,7 +38,7 @@ func NewMonomialConstant(coefficient *basicmath.Fraction) *Monomial {
 	return m
 }
 
-func NewMonomialWithExponent(coefficient *basicmath.Fraction, letter string, exponent *basicmath.Fraction) *Monomial {
+func NewMonomialWithExponent(coefficient basicmath.Rational, letter string, exponent *basicmath.Fraction) *Monomial {
 	m := &Monomial{
 		coefficient: coefficient,
 		variables:   []*Variable{NewVariableWithExponent(letter, exponent)},
@@ -50,7 +49,7 @@ func NewMonomialWithExponent(coefficient *basicmath.Fraction, letter string, exp
 	return m
 }
 
-func NewMonomialWithVariables(coefficient *basicmath.Fraction, variables ...*Variable) *Monomial {
+func NewMonomialWithVariables(coefficient basicmath.Rational, variables ...*Variable) *Monomial {
 	m := &Monomial{
 		coefficient: coefficient,
 		variables:   variables,
@@ -133,9 +132,9 @@ func (m Monomial) LaTeX() string {
 		return c
 	}
 
-	if m.coefficient.Equals(basicmath.NewInteger(1)) {
+	if basicmath.CompareRationals(m.coefficient, basicmath.NewInteger(1)) == 0 {
 		c = ""
-	} else if m.coefficient.Equals(basicmath.NewInteger(-1)) {
+	} else if basicmath.CompareRationals(m.coefficient, basicmath.NewInteger(-1)) == 0 {
 		c = "-"
 	}
 
@@ -167,7 +166,7 @@ func (m *Monomial) Subtract(others ...*Monomial) *Polynomial {
 	p.AddMonomial(m)
 
 	for _, other := range others {
-		o := NewMonomialWithVariables(other.coefficient.Multiply(basicmath.NewInteger(-1)), other.variables...)
+		o := NewMonomialWithVariables(basicmath.MultiplyRationals(other.coefficient, basicmath.NewInteger(-1)), other.variables...)
 
 		p.AddMonomial(o)
 	}
@@ -190,7 +189,7 @@ func (m *Monomial) Divide(others ...*Monomial) *Monomial {
 	temp := makeCopyOfMonomial(*m)
 
 	for _, other := range others {
-		temp.coefficient = temp.coefficient.Divide(other.coefficient)
+		temp.coefficient = basicmath.DivideRationals(temp.coefficient, other.coefficient)
 		for i, tempVar := range temp.variables {
 			for _, otherVar := range other.variables {
 				if tempVar.letter == otherVar.letter {
@@ -218,9 +217,9 @@ func (m Monomial) String() string {
 		return c
 	}
 
-	if m.coefficient.Equals(basicmath.NewInteger(1)) {
+	if basicmath.CompareRationals(m.coefficient, basicmath.NewInteger(1)) == 0 {
 		c = ""
-	} else if m.coefficient.Equals(basicmath.NewInteger(-1)) {
+	} else if basicmath.CompareRationals(m.coefficient, basicmath.NewInteger(-1)) == 0 {
 		c = "-"
 	}
 
@@ -283,27 +282,41 @@ func GetMonomialGCF(monomials ...*Monomial) *Monomial {
 	return monomials[0].GCF(monomials[1:]...)
 }
 
+// ParseToVariables reads variables as written by Monomial.Variables, e.g. "xy^2z^(1/2)"; a variable
+// whose exponent cannot be read, as in x^abc, is taken to the first power. Use TryParseToVariables to
+// have that reported as an error instead
 func ParseToVariables(variables string) []*Variable {
-	if variables == "" {
-		return nil
-	}
 	vars := []*Variable{}
-	var sb strings.Builder
+	for _, part := range splitVariables(variables) {
+		v, err := parseToVariable(part)
+		if err != nil {
+			v = NewVariable(string([]rune(part)[0]))
+		}
+		vars = append(vars, v)
+	}
 
-	for _, char := range variables {
-		if unicode.IsLetter(char) && sb.Len() > 0 {
-			vars = append(vars, parseToVariable(sb.String()))
+	if len(vars) == 0 {
+		return nil
+	}
+	return vars
+}
 
-			sb.Reset()
+// TryParseToVariables reads variables like ParseToVariables, but an exponent that is not a number, as
+// in x^abc, is an error
+func TryParseToVariables(variables string) ([]*Variable, error) {
+	vars := []*Variable{}
+	for _, part := range splitVariables(variables) {
+		v, err := parseToVariable(part)
+		if err != nil {
+			return nil, err
 		}
-		sb.WriteRune(char)
+		vars = append(vars, v)
 	}
 
-	if sb.Len() > 0 {
-		vars = append(vars, parseToVariable(sb.String()))
+	if len(vars) == 0 {
+		return nil, nil
 	}
-
-	return vars
+	return vars, nil
 }
 
 func (m *Monomial) StandardForm() *Monomial {
@@ -378,7 +391,7 @@ func (m *Monomial) calculateDegree() *basicmath.Fraction {
 }
 
 func (a *Monomial) gcd(b *Monomial) *Monomial {
-	gcf := basicmath.GetFractionGCF(a.coefficient, b.coefficient)
+	gcf := basicmath.GetRationalGCF(a.coefficient, b.coefficient)
 
 	// Create maps to track variables by their variable and exponent
 	v1 := make(map[string]*basicmath.Fraction)
@@ -415,7 +428,10 @@ func (m *Monomial) getVariableLetter() string {
 func makeCopyOfMonomial(m Monomial) *Monomial {
 	copy := &Monomial{}
 
-	copy.coefficient = basicmath.NewFraction(m.coefficient.Numerator(), m.coefficient.Denominator())
+	copy.coefficient = m.coefficient
+	if coefficient, ok := m.coefficient.(*basicmath.Fraction); ok {
+		copy.coefficient = basicmath.NewFraction(coefficient.Numerator(), coefficient.Denominator())
+	}
 
 	var variables []*Variable
 	for _, variable := range m.variables {
@@ -432,7 +448,7 @@ func makeCopyOfMonomial(m Monomial) *Monomial {
 
 func (a *Monomial) multiplyBy(b *Monomial) *Monomial {
 	m := &Monomial{}
-	m.coefficient = a.coefficient.Multiply(b.coefficient)
+	m.coefficient = basicmath.MultiplyRationals(a.coefficient, b.coefficient)
 	m.variables = a.variables
 
 	for _, other := range b.variables {
@@ -442,23 +458,48 @@ func (a *Monomial) multiplyBy(b *Monomial) *Monomial {
 	return m.StandardForm()
 }
 
-func parseToVariable(part string) *Variable {
-	var letter string
-	numerator, denominator := 1, 1
-
-	for _, item := range part {
-		if unicode.IsLetter(item) {
-			letter = string(item)
-		} else if unicode.IsNumber(item) {
-			if numerator == 1 {
-				numerator, _ = strconv.Atoi(string(item))
-			} else if denominator == 1 {
-				denominator, _ = strconv.Atoi(string(item))
-			}
+// splits text such as "xy^2z^(1/2)" into one piece per variable, each starting with its letter
+func splitVariables(variables string) []string {
+	parts := []string{}
+	var sb strings.Builder
+
+	for _, char := range variables {
+		if unicode.IsLetter(char) && sb.Len() > 0 {
+			parts = append(parts, sb.String())
+
+			sb.Reset()
 		}
+		sb.WriteRune(char)
+	}
+
+	if sb.Len() > 0 {
+		parts = append(parts, sb.String())
+	}
+
+	return parts
+}
+
+// reads one variable as written by Variable.String, e.g. x, x^12, x^-1 or x^(1/2)
+func parseToVariable(part string) (*Variable, error) {
+	runes := []rune(part)
+	if !unicode.IsLetter(runes[0]) || runes[0] >= unicode.MaxASCII {
+		return nil, fmt.Errorf("algebra: invalid variable %q", part)
+	}
+	letter := string(runes[0])
+
+	if len(runes) == 1 {
+		return NewVariable(letter), nil
+	}
+
+	text := strings.TrimPrefix(string(runes[1:]), "^")
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "("), ")")
+
+	exponent, err := basicmath.ParseFraction(text)
+	if err != nil {
+		return nil, fmt.Errorf("algebra: invalid exponent in %q: %w", part, err)
 	}
 
-	return NewVariableWithExponent(letter, basicmath.NewFraction(numerator, denominator))
+	return NewVariableWithExponent(letter, exponent), nil
 }
 
 // #endregion
@@ -399,12 +399,47 @@ func TestParseToVariables(t *testing.T) {
 			args: args{variables: "xy^2z"},
 			want: []*Variable{NewVariable("x"), NewVariableWithExponent("y", basicmath.NewInteger(2)), NewVariable("z")},
 		},
+		{
+			name: "Monomial_ParseToVariables_Test02",
+			args: args{variables: "x^12y^-3"},
+			want: []*Variable{NewVariableWithExponent("x", basicmath.NewInteger(12)), NewVariableWithExponent("y", basicmath.NewInteger(-3))},
+		},
+		{
+			name: "Monomial_ParseToVariables_Test03",
+			args: args{variables: "x^(3/10)y"},
+			want: []*Variable{NewVariableWithExponent("x", basicmath.NewFraction(3, 10)), NewVariable("y")},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if got := ParseToVariables(tt.args.variables); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("ParseToVariables() = %v, want %v", got, tt.want)
 			}
+			got, err := TryParseToVariables(tt.args.variables)
+			if err != nil {
+				t.Fatalf("TryParseToVariables() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TryParseToVariables() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTryParseToVariables_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables string
+	}{
+		{name: "Monomial_TryParseToVariables_Errors_LetterExponent", variables: "x^abc"},
+		{name: "Monomial_TryParseToVariables_Errors_BadNumber", variables: "x^1.2.3"},
+		{name: "Monomial_TryParseToVariables_Errors_NoLetter", variables: "^2x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := TryParseToVariables(tt.variables); err == nil {
+				t.Errorf("TryParseToVariables(%q) = %v, want an error", tt.variables, got)
+			}
 		})
 	}
 }
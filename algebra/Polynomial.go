@@ -71,19 +71,28 @@ func (p *Polynomial) Factor() []*Polynomial {
 
 		a, b, c := getQuadraticTrinomialTerms(p)
 
+		// factoring works on int coefficients, so one that has grown into a BigFraction is left alone
+		aCoefficient, aOk := a.coefficient.(*basicmath.Fraction)
+		bCoefficient, bOk := b.coefficient.(*basicmath.Fraction)
+		cCoefficient, cOk := c.coefficient.(*basicmath.Fraction)
+		if !aOk || !bOk || !cOk {
+			return factors
+		}
+
 		// gcf
-		gcf := basicmath.GetFractionGCF(a.coefficient, b.coefficient, c.coefficient)
+		gcf := basicmath.GetFractionGCF(aCoefficient, bCoefficient, cCoefficient)
 
-		if !a.coefficient.IsInteger() || !b.coefficient.IsInteger() || !c.coefficient.IsInteger() {
-			denominators := []int{a.coefficient.Denominator(), b.coefficient.Denominator(), c.coefficient.Denominator()}
+		if !aCoefficient.IsInteger() || !bCoefficient.IsInteger() || !cCoefficient.IsInteger() {
+			denominators := []int{aCoefficient.Denominator(), bCoefficient.Denominator(), cCoefficient.Denominator()}
 			gcf = basicmath.NewFraction(1, basicmath.Max(denominators...))
 		}
 
 		if !gcf.Equals(basicmath.NewInteger(1)) {
 			factors = append(factors, NewPolynomial(NewMonomialConstant(gcf)))
-			a.coefficient = a.coefficient.Divide(gcf)
-			b.coefficient = b.coefficient.Divide(gcf)
-			c.coefficient = c.coefficient.Divide(gcf)
+			aCoefficient = aCoefficient.Divide(gcf)
+			bCoefficient = bCoefficient.Divide(gcf)
+			cCoefficient = cCoefficient.Divide(gcf)
+			a.coefficient, b.coefficient, c.coefficient = aCoefficient, bCoefficient, cCoefficient
 		}
 		trinomial.monomials = append(trinomial.monomials, a)
 		trinomial.monomials = append(trinomial.monomials, b)
@@ -94,7 +103,7 @@ func (p *Polynomial) Factor() []*Polynomial {
 		}
 
 		// factor of a*c with sum b
-		f1, f2, isPrime := basicmath.FactorsWithSum(b.coefficient, a.coefficient.Multiply(c.coefficient))
+		f1, f2, isPrime := basicmath.FactorsWithSum(bCoefficient, aCoefficient.Multiply(cCoefficient))
 
 		if isPrime {
 			factors = append(factors, trinomial)
@@ -116,7 +125,7 @@ func (p *Polynomial) Factor() []*Polynomial {
 			leftFactored := NewPolynomial(left.monomials[0].Divide(leftGCF), left.monomials[1].Divide(leftGCF))
 			rightFactored := NewPolynomial(right.monomials[0].Divide(rightGCF), right.monomials[1].Divide(rightGCF))
 
-			if right.monomials[0].coefficient.LessThan(basicmath.NewInteger(0)) {
+			if basicmath.CompareRationals(right.monomials[0].coefficient, basicmath.NewInteger(0)) < 0 {
 				rightGCF = rightGCF.Multiply(NewMonomialConstant(basicmath.NewInteger(-1)))
 				rightFactored = rightFactored.Multiply(NewPolynomial(NewMonomialConstant(basicmath.NewInteger(-1))))
 			}
@@ -170,9 +179,9 @@ func (p Polynomial) LaTeX() string {
 	for _, monomial := range p.monomials {
 		if sb.Len() > 0 {
 			var temp *Monomial
-			if monomial.coefficient.LessThan(basicmath.NewInteger(0)) {
+			if basicmath.CompareRationals(monomial.coefficient, basicmath.NewInteger(0)) < 0 {
 				sb.WriteString(" - ")
-				temp = NewMonomialWithVariables(monomial.coefficient.Multiply(basicmath.NewInteger(-1)), monomial.variables...)
+				temp = NewMonomialWithVariables(basicmath.MultiplyRationals(monomial.coefficient, basicmath.NewInteger(-1)), monomial.variables...)
 			} else {
 				sb.WriteString(" + ")
 				temp = NewMonomialWithVariables(monomial.coefficient, monomial.variables...)
@@ -193,7 +202,7 @@ func (p Polynomial) LaTeX() string {
 func (p *Polynomial) AddMonomial(m *Monomial) {
 	for i, mono := range p.monomials {
 		if AreLikeTerms(m, mono) {
-			p.monomials[i].coefficient = p.monomials[i].coefficient.Add(m.coefficient)
+			p.monomials[i].coefficient = basicmath.AddRationals(p.monomials[i].coefficient, m.coefficient)
 			return
 		}
 	}
@@ -250,7 +259,7 @@ func (p *Polynomial) Multiply(others ...*Polynomial) *Polynomial {
 
 // Synthetic division
 func (p *Polynomial) DividedBy(linearBinomial *Polynomial) *Polynomial {
-	var coefficients []*basicmath.Fraction
+	var coefficients []basicmath.Rational
 
 	for _, monomial := range p.monomials {
 		coefficients = append(coefficients, monomial.coefficient)
@@ -258,13 +267,13 @@ func (p *Polynomial) DividedBy(linearBinomial *Polynomial) *Polynomial {
 
 	a := GetMonomialByDegree(basicmath.NewInteger(1), linearBinomial.monomials...)
 	b := GetMonomialByDegree(basicmath.NewInteger(0), linearBinomial.monomials...)
-	c := b.coefficient.Divide(a.coefficient)
+	c := basicmath.DivideRationals(b.coefficient, a.coefficient)
 
-	newCoefficients := []*basicmath.Fraction{coefficients[0]}
+	newCoefficients := []basicmath.Rational{coefficients[0]}
 	j := 0
 	for _, f := range coefficients[1:] {
-		t := c.Multiply(newCoefficients[j])
-		t = t.Add(f)
+		t := basicmath.MultiplyRationals(c, newCoefficients[j])
+		t = basicmath.AddRationals(t, f)
 		newCoefficients = append(newCoefficients, t)
 		j++
 	}
@@ -299,12 +308,12 @@ func (p *Polynomial) StandardForm() *Polynomial {
 	// Alphabetical order
 	// Combine like terms
 
-	monomialMap := make(map[string]*basicmath.Fraction)
+	monomialMap := make(map[string]basicmath.Rational)
 
 	// Combine like terms by summing coefficients
 	for _, monomial := range p.monomials {
 		if value, exists := monomialMap[monomial.Variables()]; exists {
-			c := value.Add(monomial.coefficient)
+			c := basicmath.AddRationals(value, monomial.coefficient)
 			monomialMap[monomial.Variables()] = c
 		} else {
 			monomialMap[monomial.Variables()] = monomial.coefficient
@@ -314,7 +323,7 @@ func (p *Polynomial) StandardForm() *Polynomial {
 	// Create a simplified list of terms
 	p.monomials = []*Monomial{}
 	for vars, coefficient := range monomialMap {
-		if !coefficient.Equals(basicmath.NewInteger(0)) { // skip zero coefficients
+		if basicmath.CompareRationals(coefficient, basicmath.NewInteger(0)) != 0 { // skip zero coefficients
 			v := ParseToVariables(vars)
 			p.monomials = append(p.monomials, NewMonomialWithVariables(coefficient, v...))
 		}
@@ -349,9 +358,9 @@ func (p *Polynomial) String() string {
 	for _, monomial := range p.monomials {
 		if sb.Len() > 0 {
 			var temp *Monomial
-			if monomial.coefficient.LessThan(basicmath.NewInteger(0)) {
+			if basicmath.CompareRationals(monomial.coefficient, basicmath.NewInteger(0)) < 0 {
 				sb.WriteString(" - ")
-				temp = NewMonomialWithVariables(monomial.coefficient.Multiply(basicmath.NewInteger(-1)), monomial.variables...)
+				temp = NewMonomialWithVariables(basicmath.MultiplyRationals(monomial.coefficient, basicmath.NewInteger(-1)), monomial.variables...)
 			} else {
 				sb.WriteString(" + ")
 				temp = NewMonomialWithVariables(monomial.coefficient, monomial.variables...)
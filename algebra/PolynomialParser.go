@@ -0,0 +1,520 @@
+package algebra
+
+import (
+	"fmt"
+	"mymath/basicmath"
+	"strings"
+	"unicode"
+)
+
+// MaxExpansionExponent bounds the power a sum of terms may be raised to when it is expanded
+const MaxExpansionExponent = 64
+
+type polynomialTokenKind int
+
+const (
+	numberToken polynomialTokenKind = iota
+	letterToken
+	operatorToken
+	openToken
+	closeToken
+	fractionToken
+	endToken
+)
+
+type polynomialToken struct {
+	kind     polynomialTokenKind
+	text     string
+	operator rune
+	position int
+}
+
+// #region Public Methods
+
+// ParsePolynomial reads a polynomial written as plain text, such as "3x^2y - 4/5x + 7", or as LaTeX,
+// such as `3x^{2}y-\dfrac{4}{5}x`, and returns it combined and in standard form.
+// Variables are single letters, exponents may have several digits and may be negative or fractional
+// when grouped, as in x^12, x^-1, x^(1/2) or x^{\frac{1}{2}}, and factors written side by side are multiplied.
+// Parenthesized factors are expanded, e.g. (x + 1)^2 gives x^2 + 2x + 1, up to the power MaxExpansionExponent.
+// Division is only by constants, so 4/5x is four fifths of x. Errors are *SyntaxError values giving the column of the problem.
+func ParsePolynomial(s string) (*Polynomial, error) {
+	tokens, err := tokenizePolynomial(s)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &polynomialParser{tokens: tokens}
+	p, err := parser.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := parser.peek(); token.kind != endToken {
+		return nil, parser.unexpected(token)
+	}
+
+	return p, nil
+}
+
+// ParseMonomial reads a single term, such as "3x^2y" or `-\dfrac{4}{5}x^{12}`, with the same rules as
+// ParsePolynomial; text that works out to more than one term, such as "x + 1", is an error
+func ParseMonomial(s string) (*Monomial, error) {
+	p, err := ParsePolynomial(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(p.monomials) {
+	case 0:
+		return NewMonomialConstant(basicmath.NewInteger(0)), nil
+	case 1:
+		return p.monomials[0], nil
+	}
+	return nil, fmt.Errorf("algebra: %q is not a single term", s)
+}
+
+// #endregion
+
+// #region Private Methods
+
+func tokenizePolynomial(s string) ([]polynomialToken, error) {
+	runes := []rune(s)
+	tokens := []polynomialToken{}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		position := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			text := string(runes[start:i])
+			if strings.Count(text, ".") > 1 || text == "." {
+				return nil, &SyntaxError{Position: position, Message: fmt.Sprintf("invalid number %q", text)}
+			}
+			tokens = append(tokens, polynomialToken{kind: numberToken, text: text, position: position})
+		case r < unicode.MaxASCII && unicode.IsLetter(r):
+			tokens = append(tokens, polynomialToken{kind: letterToken, text: string(r), position: position})
+			i++
+		case r == '(' || r == '{':
+			tokens = append(tokens, polynomialToken{kind: openToken, text: string(r), position: position})
+			i++
+		case r == ')' || r == '}':
+			tokens = append(tokens, polynomialToken{kind: closeToken, text: string(r), position: position})
+			i++
+		case r == '\\':
+			start := i
+			i++
+			for i < len(runes) && runes[i] < unicode.MaxASCII && unicode.IsLetter(runes[i]) {
+				i++
+			}
+			if i == start+1 && i < len(runes) {
+				// a spacing command such as \, or \;
+				i++
+			}
+			command := string(runes[start:i])
+
+			switch command {
+			case `\frac`, `\dfrac`, `\tfrac`:
+				tokens = append(tokens, polynomialToken{kind: fractionToken, text: command, position: position})
+			case `\cdot`, `\times`:
+				tokens = append(tokens, polynomialToken{kind: operatorToken, text: command, operator: '*', position: position})
+			case `\div`:
+				tokens = append(tokens, polynomialToken{kind: operatorToken, text: command, operator: '/', position: position})
+			case `\left`, `\right`, `\,`, `\:`, `\;`, `\!`, `\ `:
+				// sizing and spacing do not change the value
+			default:
+				return nil, &SyntaxError{Position: position, Message: fmt.Sprintf("unknown command %q", command)}
+			}
+		default:
+			operator, ok := polynomialOperators[r]
+			if !ok {
+				return nil, &SyntaxError{Position: position, Message: fmt.Sprintf("unexpected character %q", r)}
+			}
+			tokens = append(tokens, polynomialToken{kind: operatorToken, text: string(r), operator: operator, position: position})
+			i++
+		}
+	}
+
+	return append(tokens, polynomialToken{kind: endToken, position: len(runes) + 1}), nil
+}
+
+// maps every accepted operator symbol to its ASCII form
+var polynomialOperators = map[rune]rune{
+	'+': '+',
+	'-': '-',
+	'−': '-',
+	'*': '*',
+	'×': '*',
+	'·': '*',
+	'⋅': '*',
+	'/': '/',
+	'÷': '/',
+	'^': '^',
+}
+
+// matching closing symbol for each grouping symbol
+var polynomialClosers = map[string]string{"(": ")", "{": "}"}
+
+// recursive descent parser that works on polynomials; from lowest to highest precedence:
+//
+//	sum      = product { ("+" | "-") product }
+//	product  = unary { ("*" | "/") unary | implicit power }
+//	unary    = "-" unary | "+" unary | power
+//	power    = primary [ "^" exponent ]
+//	exponent = "-" exponent | "+" exponent | number | group | fraction
+//	primary  = number | letter | group | fraction
+//	group    = "(" sum ")" | "{" sum "}"
+//	fraction = "\frac" "{" sum "}" "{" sum "}"
+type polynomialParser struct {
+	tokens  []polynomialToken
+	current int
+}
+
+func (p *polynomialParser) peek() polynomialToken {
+	return p.tokens[p.current]
+}
+
+func (p *polynomialParser) next() polynomialToken {
+	token := p.tokens[p.current]
+	if token.kind != endToken {
+		p.current++
+	}
+	return token
+}
+
+func (p *polynomialParser) unexpected(token polynomialToken) error {
+	if token.kind == endToken {
+		return &SyntaxError{Position: token.position, Message: "unexpected end of polynomial"}
+	}
+	return &SyntaxError{Position: token.position, Message: fmt.Sprintf("unexpected %q", token.text)}
+}
+
+func (p *polynomialParser) isOperator(operators ...rune) bool {
+	token := p.peek()
+	if token.kind != operatorToken {
+		return false
+	}
+	for _, operator := range operators {
+		if token.operator == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *polynomialParser) parseSum() (*Polynomial, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator('+', '-') {
+		operator := p.next().operator
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		if operator == '-' {
+			right = scalePolynomial(right, basicmath.NewInteger(-1))
+		}
+		left = addPolynomials(left, right)
+	}
+
+	return left, nil
+}
+
+func (p *polynomialParser) parseProduct() (*Polynomial, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		operator := '*'
+		switch {
+		case p.isOperator('*', '/'):
+			operator = p.next().operator
+		case p.implicitMultiplication():
+		default:
+			return left, nil
+		}
+
+		position := p.peek().position
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		if operator == '*' {
+			left = multiplyPolynomials(left, right)
+			continue
+		}
+
+		divisor, ok := constantValue(right)
+		if !ok {
+			return nil, &SyntaxError{Position: position, Message: "cannot divide by a variable"}
+		}
+		if basicmath.CompareRationals(divisor, basicmath.NewInteger(0)) == 0 {
+			return nil, &SyntaxError{Position: position, Message: "division by zero"}
+		}
+		left = scalePolynomial(left, basicmath.DivideRationals(basicmath.NewInteger(1), divisor))
+	}
+}
+
+// a letter, group or fraction straight after an operand, as in 3xy, 2(x + 1) or \frac{1}{2}x,
+// or a number straight after a closing parenthesis
+func (p *polynomialParser) implicitMultiplication() bool {
+	token := p.peek()
+	previous := p.tokens[p.current-1]
+	switch token.kind {
+	case letterToken, openToken, fractionToken:
+		return true
+	case numberToken:
+		return previous.kind == closeToken
+	}
+	return false
+}
+
+func (p *polynomialParser) parseUnary() (*Polynomial, error) {
+	if !p.isOperator('+', '-') {
+		return p.parsePower()
+	}
+
+	operator := p.next().operator
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if operator == '-' {
+		operand = scalePolynomial(operand, basicmath.NewInteger(-1))
+	}
+	return operand, nil
+}
+
+func (p *polynomialParser) parsePower() (*Polynomial, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isOperator('^') {
+		return base, nil
+	}
+	caret := p.next()
+
+	exponent, err := p.parseExponent()
+	if err != nil {
+		return nil, err
+	}
+
+	power, err := raisePolynomial(base, exponent)
+	if err != nil {
+		return nil, &SyntaxError{Position: caret.position, Message: err.Error()}
+	}
+	return power, nil
+}
+
+// an exponent is a single number, group or fraction that must work out to a constant,
+// so x^2y is x squared times y and x^1/2 is half of x
+func (p *polynomialParser) parseExponent() (*basicmath.Fraction, error) {
+	if p.isOperator('+', '-') {
+		sign := p.next()
+		exponent, err := p.parseExponent()
+		if err != nil {
+			return nil, err
+		}
+		if sign.operator == '-' {
+			exponent, err = exponent.TryMultiply(basicmath.NewInteger(-1))
+			if err != nil {
+				return nil, &SyntaxError{Position: sign.position, Message: err.Error()}
+			}
+		}
+		return exponent, nil
+	}
+
+	token := p.peek()
+	if token.kind == letterToken {
+		return nil, &SyntaxError{Position: token.position, Message: "exponent must be a number"}
+	}
+
+	value, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	constant, ok := constantValue(value)
+	if !ok {
+		return nil, &SyntaxError{Position: token.position, Message: "exponent must be a number"}
+	}
+	exponent, ok := constant.(*basicmath.Fraction)
+	if !ok {
+		return nil, &SyntaxError{Position: token.position, Message: basicmath.ErrOverflow.Error()}
+	}
+	return exponent, nil
+}
+
+func (p *polynomialParser) parsePrimary() (*Polynomial, error) {
+	token := p.next()
+
+	switch token.kind {
+	case numberToken:
+		value, err := basicmath.ParseFraction(token.text)
+		if err != nil {
+			return nil, &SyntaxError{Position: token.position, Message: fmt.Sprintf("invalid number %q", token.text)}
+		}
+		return constantPolynomial(value), nil
+	case letterToken:
+		return NewPolynomial(NewMonomial(basicmath.NewInteger(1), token.text)), nil
+	case openToken:
+		return p.parseGroup(token)
+	case fractionToken:
+		numerator, err := p.parseBraced()
+		if err != nil {
+			return nil, err
+		}
+		position := p.peek().position
+		denominator, err := p.parseBraced()
+		if err != nil {
+			return nil, err
+		}
+
+		divisor, ok := constantValue(denominator)
+		if !ok {
+			return nil, &SyntaxError{Position: position, Message: "cannot divide by a variable"}
+		}
+		if basicmath.CompareRationals(divisor, basicmath.NewInteger(0)) == 0 {
+			return nil, &SyntaxError{Position: position, Message: "division by zero"}
+		}
+		return scalePolynomial(numerator, basicmath.DivideRationals(basicmath.NewInteger(1), divisor)), nil
+	}
+
+	return nil, p.unexpected(token)
+}
+
+// reads the rest of a group after its opening symbol, up to the matching closing symbol
+func (p *polynomialParser) parseGroup(open polynomialToken) (*Polynomial, error) {
+	inner, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	closing := p.next()
+	if closing.kind == endToken {
+		return nil, &SyntaxError{Position: open.position, Message: fmt.Sprintf("unmatched %q", open.text)}
+	}
+	if closing.kind != closeToken || closing.text != polynomialClosers[open.text] {
+		return nil, p.unexpected(closing)
+	}
+	return inner, nil
+}
+
+// reads an argument of a LaTeX command, such as {3x} in \frac{3x}{4}
+func (p *polynomialParser) parseBraced() (*Polynomial, error) {
+	open := p.next()
+	if open.kind != openToken || open.text != "{" {
+		if open.kind == endToken {
+			return nil, p.unexpected(open)
+		}
+		return nil, &SyntaxError{Position: open.position, Message: fmt.Sprintf(`expected "{" but found %q`, open.text)}
+	}
+	return p.parseGroup(open)
+}
+
+func constantPolynomial(value *basicmath.Fraction) *Polynomial {
+	return NewPolynomial(NewMonomialConstant(value)).StandardForm()
+}
+
+// the value of a polynomial with no variables; the zero polynomial has no terms at all
+func constantValue(p *Polynomial) (basicmath.Rational, bool) {
+	switch {
+	case len(p.monomials) == 0:
+		return basicmath.NewInteger(0), true
+	case len(p.monomials) == 1 && p.monomials[0].Degree().Equals(basicmath.NewInteger(0)) && p.monomials[0].Variables() == "":
+		return p.monomials[0].coefficient, true
+	}
+	return nil, false
+}
+
+func addPolynomials(a, b *Polynomial) *Polynomial {
+	sum := makeCopyOfPolynomial(a)
+	sum.monomials = append(sum.monomials, makeCopyOfPolynomial(b).monomials...)
+	return sum.StandardForm()
+}
+
+func multiplyPolynomials(a, b *Polynomial) *Polynomial {
+	return NewPolynomial(multiplyTwoPolynomials(a, b)...).StandardForm()
+}
+
+func scalePolynomial(p *Polynomial, factor basicmath.Rational) *Polynomial {
+	return multiplyPolynomials(p, NewPolynomial(NewMonomialConstant(factor)))
+}
+
+// raises a single term to any power, e.g. (4x^2)^(1/2) is 2x, and expands a sum of terms
+// raised to a whole number, e.g. (x + 1)^2 is x^2 + 2x + 1
+func raisePolynomial(base *Polynomial, exponent *basicmath.Fraction) (*Polynomial, error) {
+	if len(base.monomials) == 0 {
+		if exponent.Numerator() <= 0 {
+			return nil, fmt.Errorf("zero cannot be raised to the power %s", exponent)
+		}
+		return base, nil
+	}
+
+	if len(base.monomials) == 1 {
+		term := base.monomials[0]
+		coefficient, err := raiseCoefficient(term.coefficient, exponent)
+		if err != nil {
+			return nil, err
+		}
+
+		variables := []*Variable{}
+		for _, variable := range term.variables {
+			power, err := variable.exponent.TryMultiply(exponent)
+			if err != nil {
+				return nil, err
+			}
+			variables = append(variables, NewVariableWithExponent(variable.Letter(), power))
+		}
+		return NewPolynomial(NewMonomialWithVariables(coefficient, variables...)).StandardForm(), nil
+	}
+
+	if !exponent.IsInteger() || exponent.Numerator() < 0 {
+		return nil, fmt.Errorf("a sum can only be raised to a whole number power, not %s", exponent)
+	}
+	if exponent.GreaterThan(basicmath.NewInteger(MaxExpansionExponent)) {
+		return nil, fmt.Errorf("a sum can only be expanded up to the power %d, not %s", MaxExpansionExponent, exponent)
+	}
+
+	power := constantPolynomial(basicmath.NewInteger(1))
+	for i := 0; i < exponent.Numerator()/exponent.Denominator(); i++ {
+		power = multiplyPolynomials(power, base)
+	}
+	return power, nil
+}
+
+// only coefficients that still fit in an int can be raised to a power; a larger one is ErrOverflow
+func raiseCoefficient(rational basicmath.Rational, exponent *basicmath.Fraction) (*basicmath.Fraction, error) {
+	coefficient, ok := rational.(*basicmath.Fraction)
+	if !ok {
+		return nil, basicmath.ErrOverflow
+	}
+
+	if exponent.IsInteger() {
+		return coefficient.TryPow(exponent.Numerator() / exponent.Denominator())
+	}
+
+	root, err := coefficient.PowFraction(exponent)
+	if err == nil {
+		if value, ok := root.ToFraction(); ok {
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("%s to the power %s is not rational", coefficient, exponent)
+}
+
+// #endregion
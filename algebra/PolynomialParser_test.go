@@ -0,0 +1,127 @@
+package algebra
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePolynomial(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"ParsePolynomial_PlainText", "3x^2y - 4/5x + 7", "3x^2y - 4/5x + 7"},
+		{"ParsePolynomial_LaTeX", `3x^{2}y-\dfrac{4}{5}x`, "3x^2y - 4/5x"},
+		{"ParsePolynomial_MultiDigitExponent", "x^12 + x", "x^12 + x"},
+		{"ParsePolynomial_NegativeExponent", "x^-2", "x^-2"},
+		{"ParsePolynomial_FractionalExponent", "x^(1/2)y", "x^(1/2)y"},
+		{"ParsePolynomial_LaTeXFractionalExponent", `x^{\frac{1}{2}}`, "x^(1/2)"},
+		{"ParsePolynomial_ImplicitMultiplication", "2xy * 3x", "6x^2y"},
+		{"ParsePolynomial_VariableOrder", "yx^2", "x^2y"},
+		{"ParsePolynomial_CombineLikeTerms", "2x + 3 - x + 4", "x + 7"},
+		{"ParsePolynomial_Decimal", "1.5x", "3/2x"},
+		{"ParsePolynomial_DivideByConstant", "(6x + 4) / 2", "3x + 2"},
+		{"ParsePolynomial_Product", "(x + 1)(x - 1)", "x^2 - 1"},
+		{"ParsePolynomial_Square", "(x + 1)^2", "x^2 + 2x + 1"},
+		{"ParsePolynomial_Cube", "2(x + y)^3", "2x^3 + 6x^2y + 6xy^2 + 2y^3"},
+		{"ParsePolynomial_LaTeXGroups", `\left(x + 1\right)^{2} \cdot 3`, "3x^2 + 6x + 3"},
+		{"ParsePolynomial_PowerOfTerm", "(4x^2)^(1/2)", "2x"},
+		{"ParsePolynomial_NegatedPower", "-x^2", "-x^2"},
+		{"ParsePolynomial_ConstantPower", "2^3x", "8x"},
+		{"ParsePolynomial_HugePowerOfOne", "1^1000000000000 x", "x"},
+		{"ParsePolynomial_HugePowerOfMinusOne", "(-1)^1000000000001 x", "-x"},
+		{"ParsePolynomial_HugeVariableExponent", "x^1000000000000", "x^1000000000000"},
+		{"ParsePolynomial_BigCoefficient", "9223372036854775807x + 9223372036854775807x", "18446744073709551614x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePolynomial(tt.input)
+			if err != nil {
+				t.Fatalf("ParsePolynomial() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParsePolynomial() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePolynomial_LaTeX(t *testing.T) {
+	got, err := ParsePolynomial("3x^2y - 4/5x + 7")
+	if err != nil {
+		t.Fatalf("ParsePolynomial() error = %v", err)
+	}
+	if want := `3x^{2}y - \dfrac{4}{5}x + 7`; got.LaTeX() != want {
+		t.Errorf("ParsePolynomial().LaTeX() = %v, want %v", got.LaTeX(), want)
+	}
+}
+
+func TestParsePolynomial_SyntaxErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantPosition int
+		wantMessage  string
+	}{
+		{"ParsePolynomial_SyntaxErrors_Empty", "", 1, "unexpected end of polynomial"},
+		{"ParsePolynomial_SyntaxErrors_TrailingOperator", "x +", 4, "unexpected end of polynomial"},
+		{"ParsePolynomial_SyntaxErrors_Unmatched", "2(x + 1", 2, `unmatched "("`},
+		{"ParsePolynomial_SyntaxErrors_MismatchedBrace", "(x + 1}", 7, `unexpected "}"`},
+		{"ParsePolynomial_SyntaxErrors_Character", "3x $ 4", 4, `unexpected character '$'`},
+		{"ParsePolynomial_SyntaxErrors_Number", "1.2.3x", 1, `invalid number "1.2.3"`},
+		{"ParsePolynomial_SyntaxErrors_Command", `\sqrt{x}`, 1, `unknown command "\\sqrt"`},
+		{"ParsePolynomial_SyntaxErrors_VariableExponent", "x^y", 3, "exponent must be a number"},
+		{"ParsePolynomial_SyntaxErrors_DivideByVariable", "1/x", 3, "cannot divide by a variable"},
+		{"ParsePolynomial_SyntaxErrors_DivideByZero", `\frac{x}{0}`, 9, "division by zero"},
+		{"ParsePolynomial_SyntaxErrors_NegativePowerOfSum", "(x + 1)^-1", 8, "a sum can only be raised to a whole number power, not -1"},
+		{"ParsePolynomial_SyntaxErrors_ExpansionTooLarge", "(x + 1)^100000000", 8, "a sum can only be expanded up to the power 64, not 100000000"},
+		{"ParsePolynomial_SyntaxErrors_CoefficientOverflow", "2^1000000000000", 2, "basicmath: integer overflow"},
+		{"ParsePolynomial_SyntaxErrors_ExponentOverflow", "(x^99999999999)^99999999999", 16, "basicmath: integer overflow"},
+		{"ParsePolynomial_SyntaxErrors_IrrationalCoefficient", "(2x)^(1/2)", 5, "2 to the power 1/2 is not rational"},
+		{"ParsePolynomial_SyntaxErrors_FractionArgument", `\frac 1 2`, 7, `expected "{" but found "1"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePolynomial(tt.input)
+
+			var syntaxError *SyntaxError
+			if !errors.As(err, &syntaxError) {
+				t.Fatalf("ParsePolynomial() error = %v, want a *SyntaxError", err)
+			}
+			if syntaxError.Position != tt.wantPosition || syntaxError.Message != tt.wantMessage {
+				t.Errorf("ParsePolynomial() error = %v, want %q at position %d", err, tt.wantMessage, tt.wantPosition)
+			}
+		})
+	}
+}
+
+func TestParseMonomial(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"ParseMonomial_Term", "3x^2y", "3x^2y"},
+		{"ParseMonomial_LaTeX", `-\dfrac{4}{5}x^{12}`, "-4/5x^12"},
+		{"ParseMonomial_Constant", "7", "7"},
+		{"ParseMonomial_Zero", "x - x", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMonomial(tt.input)
+			if err != nil {
+				t.Fatalf("ParseMonomial() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseMonomial() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, input := range []string{"x + 1", "x^abc", "x^1.2.3"} {
+		if got, err := ParseMonomial(input); err == nil {
+			t.Errorf("ParseMonomial(%q) = %v, want an error", input, got)
+		}
+	}
+}
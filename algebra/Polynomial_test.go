@@ -2,6 +2,8 @@ package algebra
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"mymath/basicmath"
 	"reflect"
 	"testing"
@@ -377,7 +379,7 @@ func TestPolynomial_StandardForm(t *testing.T) {
 
 						compareValues(fmt.Sprintf("%d: coefficient", i), a.coefficient, b.coefficient)
 
-						if !a.coefficient.Equals(b.coefficient) {
+						if basicmath.CompareRationals(a.coefficient, b.coefficient) != 0 {
 							fmt.Printf("got.monomials[%d].coefficient (%v) not equal to tt.want.monomials[%d].coefficient (%v)\n",
 								i, got.monomials[i].coefficient, i, tt.want.monomials[i].coefficient)
 						}
@@ -906,3 +908,23 @@ func TestPolynomial_Factor(t *testing.T) {
 		})
 	}
 }
+
+func TestPolynomial_BigCoefficients(t *testing.T) {
+	huge := basicmath.NewInteger(math.MaxInt)
+
+	sum := NewMonomial(huge, "x").Add(NewMonomial(huge, "x")).StandardForm()
+	if got, want := sum.String(), "18446744073709551614x"; got != want {
+		t.Errorf("Add() = %v, want %v", got, want)
+	}
+
+	product := NewPolynomial(NewMonomial(huge, "x"), NewMonomialConstant(basicmath.NewInteger(1))).
+		Multiply(NewPolynomial(NewMonomial(basicmath.NewInteger(2), "x")))
+	if got, want := product.LaTeX(), "18446744073709551614x^{2} + 2x"; got != want {
+		t.Errorf("Multiply() = %v, want %v", got, want)
+	}
+
+	constant := NewMonomialConstant(basicmath.NewBigFractionFromBigInts(big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 70)))
+	if got, want := constant.String(), "1/1180591620717411303424"; got != want {
+		t.Errorf("NewMonomialConstant() = %v, want %v", got, want)
+	}
+}
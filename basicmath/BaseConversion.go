@@ -0,0 +1,384 @@
+package basicmath
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// #region Constructor
+
+// BaseExpansion is a number written in a base from 2 to 36, split like RepeatingDecimal into an
+// integer part, the fractional digits that do not repeat and the repeating block.
+// Digits above 9 are the letters A to Z, e.g. 1/3 in base 2 is 0.(01) and 26.5 in base 16 is 1A.8.
+type BaseExpansion struct {
+	base         int
+	negative     bool
+	integer      string
+	nonRepeating string
+	repeating    string
+}
+
+// ToBase expands the fraction in base by repeated division of the integer part and
+// repeated multiplication of the fractional part, detecting the repeating block
+func (f *Fraction) ToBase(base int) (*BaseExpansion, error) {
+	return f.toBase(base, nil)
+}
+
+// ToBaseWithSteps expands like ToBase and also returns the work: dividing the integer part by the base
+// and reading the remainders from the bottom up, then multiplying the fractional part by the base and
+// reading the whole-number parts from the top down
+func (f *Fraction) ToBaseWithSteps(base int) (*BaseExpansion, *StepLog, error) {
+	steps := &StepLog{}
+	expansion, err := f.toBase(base, steps)
+	if err != nil {
+		return nil, nil, err
+	}
+	return expansion, steps, nil
+}
+
+// ConvertBase rewrites a number such as "1011.01" or "0.(3)" from one base to another; see ParseInBase
+func ConvertBase(s string, from int, to int) (*BaseExpansion, error) {
+	value, err := ParseInBase(s, from)
+	if err != nil {
+		return nil, err
+	}
+	return value.ToBase(to)
+}
+
+// #endregion
+
+// #region Properties
+
+func (b *BaseExpansion) Base() int {
+	return b.base
+}
+
+// IntegerDigits returns the digits of the whole-number part, e.g. "1A" for 1A.8 in base 16
+func (b *BaseExpansion) IntegerDigits() string {
+	return b.integer
+}
+
+func (b *BaseExpansion) IsNegative() bool {
+	return b.negative
+}
+
+func (b *BaseExpansion) NonRepeating() string {
+	return b.nonRepeating
+}
+
+func (b *BaseExpansion) Repeating() string {
+	return b.repeating
+}
+
+// #endregion
+
+// #region LaTeXer
+
+// LaTeX overlines the repeating block and writes the base as a subscript, e.g. 0.\overline{01}_{2}
+func (b *BaseExpansion) LaTeX() string {
+	var sb strings.Builder
+
+	sb.WriteString(b.sign())
+	sb.WriteString(b.integer)
+
+	if b.nonRepeating != "" || b.repeating != "" {
+		sb.WriteString(".")
+		sb.WriteString(b.nonRepeating)
+		if b.repeating != "" {
+			sb.WriteString(fmt.Sprintf(`\overline{%s}`, b.repeating))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("_{%d}", b.base))
+	return sb.String()
+}
+
+// #endregion
+
+// #region Stringer
+
+// String uses parentheses around the repeating block, e.g. 0.(01), which ParseInBase reads back
+func (b *BaseExpansion) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(b.sign())
+	sb.WriteString(b.integer)
+
+	if b.nonRepeating != "" || b.repeating != "" {
+		sb.WriteString(".")
+		sb.WriteString(b.nonRepeating)
+		if b.repeating != "" {
+			sb.WriteString(fmt.Sprintf("(%s)", b.repeating))
+		}
+	}
+
+	return sb.String()
+}
+
+// #endregion
+
+// #region Public Methods
+
+// IsTerminating reports whether the expansion ends (has no repeating block)
+func (b *BaseExpansion) IsTerminating() bool {
+	return b.repeating == ""
+}
+
+// ToFraction converts the expansion back to an exact fraction
+func (b *BaseExpansion) ToFraction() (*Fraction, error) {
+	return decimalPartsToFraction(b.negative, b.integer, b.nonRepeating, b.repeating, b.base)
+}
+
+// ParseInBase reads a number written in base, such as "-1011.01" in base 2, "1a.8" in base 16 or
+// "0.(01)" with a repeating block in parentheses, into an exact fraction
+func ParseInBase(s string, base int) (*Fraction, error) {
+	value, _, err := parseInBase(s, base, nil)
+	return value, err
+}
+
+// ParseInBaseWithSteps parses like ParseInBase and also returns the work: each digit times its place
+// value, with a repeating block worth its digits over (base^r - 1) · base^p for a block of r digits
+// after p non-repeating ones
+func ParseInBaseWithSteps(s string, base int) (*Fraction, *StepLog, error) {
+	steps := &StepLog{}
+	value, _, err := parseInBase(s, base, steps)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, steps, nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+func (b *BaseExpansion) sign() string {
+	if b.negative {
+		return "-"
+	}
+	return ""
+}
+
+func validBase(base int) bool {
+	return base >= 2 && base <= 36
+}
+
+func (f *Fraction) toBase(base int, steps *StepLog) (*BaseExpansion, error) {
+	if !validBase(base) {
+		return nil, ErrInvalidBase
+	}
+	if f.n == math.MinInt {
+		return nil, ErrOverflow
+	}
+
+	value := f.simplifiedCopy()
+	integer, prefix, cycle, err := expandFraction(value.n, value.d, base)
+	if err != nil {
+		return nil, err
+	}
+
+	expansion := &BaseExpansion{
+		base:         base,
+		negative:     value.n < 0,
+		integer:      strings.ToUpper(strconv.FormatInt(int64(integer), base)),
+		nonRepeating: digitsToString(prefix),
+		repeating:    digitsToString(cycle),
+	}
+
+	if steps != nil && value.n == 0 {
+		steps.Add("", fmt.Sprintf("0 = %s", expansion.LaTeX()))
+	} else if steps != nil {
+		integerSteps(integer, base, expansion.integer, steps)
+		fractionalSteps(Abs(value.n)%value.d, value.d, base, len(prefix), len(cycle), steps)
+
+		result := expansion.LaTeX()
+		if expansion.negative {
+			steps.Add("put the sign back", fmt.Sprintf("%s = %s", value.LaTeX(), result))
+		} else if integer != 0 && Abs(value.n)%value.d != 0 {
+			steps.Add("combine the integer and fractional parts", fmt.Sprintf("%s = %s", value.LaTeX(), result))
+		}
+	}
+
+	return expansion, nil
+}
+
+// records the repeated division of the integer part by base
+func integerSteps(integer, base int, digits string, steps *StepLog) {
+	if integer == 0 {
+		return
+	}
+
+	for n := integer; n > 0; n /= base {
+		remainder := n % base
+		expression := fmt.Sprintf(`%d \div %d = %d \text{ remainder } %d`, n, base, n/base, remainder)
+		if remainder >= 10 {
+			expression += fmt.Sprintf(`\ (\mathrm{%s})`, digitsToString([]int{remainder}))
+		}
+		steps.Add(fmt.Sprintf("divide by %d", base), expression)
+	}
+
+	steps.Add("read the remainders from the bottom up", fmt.Sprintf("%d = %s_{%d}", integer, digits, base))
+}
+
+// records the repeated multiplication of the fractional part remainder/d by base
+func fractionalSteps(remainder, d, base, prefixLength, cycleLength int, steps *StepLog) {
+	if remainder == 0 {
+		return
+	}
+
+	first, start := remainder, remainder
+	digits := []int{}
+	for i := 0; i < prefixLength+cycleLength; i++ {
+		if i == prefixLength {
+			start = remainder
+		}
+
+		digit, next := nextDigit(remainder, d, base)
+		digits = append(digits, digit)
+
+		expression := fmt.Sprintf(`%s \cdot %d = %d`, NewFraction(remainder, d).simplifiedCopy().LaTeX(), base, digit)
+		if next != 0 {
+			expression += " + " + NewFraction(next, d).simplifiedCopy().LaTeX()
+		}
+		steps.Add(fmt.Sprintf("multiply by %d, digit %s", base, digitsToString([]int{digit})), expression)
+
+		remainder = next
+	}
+
+	if cycleLength > 0 {
+		steps.Add("the fractional part repeats, so the digits from there repeat", NewFraction(start, d).simplifiedCopy().LaTeX())
+	}
+
+	fractional := &BaseExpansion{base: base, integer: "0", nonRepeating: digitsToString(digits[:prefixLength]), repeating: digitsToString(digits[prefixLength:])}
+	steps.Add("read the whole-number parts from the top down", fmt.Sprintf("%s = %s", NewFraction(first, d).simplifiedCopy().LaTeX(), fractional.LaTeX()))
+}
+
+func parseInBase(s string, base int, steps *StepLog) (*Fraction, *BaseExpansion, error) {
+	if !validBase(base) {
+		return nil, nil, ErrInvalidBase
+	}
+
+	text := strings.TrimSpace(strings.ReplaceAll(s, "−", "-"))
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(strings.TrimPrefix(text, "-"), "+")
+
+	integer, fractional, _ := strings.Cut(text, ".")
+	nonRepeating, repeating := fractional, ""
+	if before, after, found := strings.Cut(fractional, "("); found {
+		block, rest, closed := strings.Cut(after, ")")
+		if !closed || rest != "" || block == "" {
+			return nil, nil, fmt.Errorf("basicmath: invalid number %q in base %d", s, base)
+		}
+		nonRepeating, repeating = before, block
+	}
+	if !isDigits(integer, base) || !isDigits(nonRepeating, base) || !isDigits(repeating, base) {
+		return nil, nil, fmt.Errorf("basicmath: invalid number %q in base %d", s, base)
+	}
+	if integer == "" && nonRepeating == "" && repeating == "" {
+		return nil, nil, fmt.Errorf("basicmath: invalid number %q in base %d: no digits", s, base)
+	}
+	if integer == "" {
+		integer = "0"
+	}
+
+	expansion := &BaseExpansion{
+		base:         base,
+		negative:     negative,
+		integer:      strings.ToUpper(integer),
+		nonRepeating: strings.ToUpper(nonRepeating),
+		repeating:    strings.ToUpper(repeating),
+	}
+	value, err := expansion.ToFraction()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if steps != nil {
+		if err := placeValueSteps(expansion, value, steps); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return value, expansion, nil
+}
+
+// records the expansion of each digit times its place value
+func placeValueSteps(expansion *BaseExpansion, value *Fraction, steps *StepLog) error {
+	terms := []string{}
+	evaluated := []string{}
+
+	digits := strings.TrimLeft(expansion.integer, "0")
+	all := digits + expansion.nonRepeating
+	for i, r := range all {
+		digit, _ := strconv.ParseInt(string(r), expansion.base, 64)
+		if digit == 0 {
+			continue
+		}
+		power := len(digits) - 1 - i
+		place, err := placeValue(int(digit), expansion.base, power)
+		if err != nil {
+			return err
+		}
+		terms = append(terms, fmt.Sprintf(`%d \cdot %d^{%d}`, digit, expansion.base, power))
+		evaluated = append(evaluated, place.LaTeX())
+	}
+
+	if expansion.repeating != "" {
+		// the cycle can be far longer than an int holds, e.g. the 96 digits of 1/97
+		digits, ok := new(big.Int).SetString(expansion.repeating, expansion.base)
+		if !ok {
+			return fmt.Errorf("basicmath: invalid digits %q for base %d", expansion.repeating, expansion.base)
+		}
+		denominator := fmt.Sprintf(`%d^{%d} - 1`, expansion.base, len(expansion.repeating))
+		if p := len(expansion.nonRepeating); p > 0 {
+			denominator = fmt.Sprintf(`\left(%s\right) \cdot %d^{%d}`, denominator, expansion.base, p)
+		}
+		terms = append(terms, fmt.Sprintf(`\dfrac{%s}{%s}`, digits, denominator))
+		block, err := decimalPartsToRat(false, "0", strings.Repeat("0", len(expansion.nonRepeating)), expansion.repeating, expansion.base)
+		if err != nil {
+			return err
+		}
+		evaluated = append(evaluated, (&BigFraction{r: block}).LaTeX())
+	}
+
+	if len(terms) == 0 {
+		terms, evaluated = []string{"0"}, []string{"0"}
+	}
+
+	sum := strings.Join(terms, " + ")
+	if expansion.negative {
+		sum = fmt.Sprintf(`-\left(%s\right)`, sum)
+	}
+
+	steps.Add("write each digit times its place value", fmt.Sprintf("%s = %s", expansion.LaTeX(), sum))
+	if len(evaluated) > 1 || expansion.negative {
+		values := strings.Join(evaluated, " + ")
+		if expansion.negative {
+			values = fmt.Sprintf(`-\left(%s\right)`, values)
+		}
+		steps.Add("evaluate each place value", "= "+values)
+	}
+	steps.Add("add", "= "+value.LaTeX())
+	return nil
+}
+
+// digit × base^power as an exact fraction; power may be negative
+func placeValue(digit, base, power int) (*Fraction, error) {
+	factor, ok := powInt(base, Abs(power))
+	if !ok {
+		return nil, ErrOverflow
+	}
+	if power < 0 {
+		return NewFraction(digit, factor), nil
+	}
+	value, ok := multiplyInts(digit, factor)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	return NewInteger(value), nil
+}
+
+// #endregion
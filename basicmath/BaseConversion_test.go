@@ -0,0 +1,201 @@
+package basicmath
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFraction_ToBase(t *testing.T) {
+	tests := []struct {
+		name      string
+		fraction  *Fraction
+		base      int
+		want      string
+		wantLaTeX string
+	}{
+		{"Fraction_ToBase_Binary", NewInteger(45), 2, "101101", "101101_{2}"},
+		{"Fraction_ToBase_Hex", NewInteger(255), 16, "FF", "FF_{16}"},
+		{"Fraction_ToBase_Base36", NewInteger(35), 36, "Z", "Z_{36}"},
+		{"Fraction_ToBase_Zero", NewInteger(0), 7, "0", "0_{7}"},
+		{"Fraction_ToBase_TerminatingBinary", NewFraction(3, 8), 2, "0.011", "0.011_{2}"},
+		{"Fraction_ToBase_RepeatingBinary", NewFraction(1, 3), 2, "0.(01)", `0.\overline{01}_{2}`},
+		{"Fraction_ToBase_TenthInBinary", NewFraction(1, 10), 2, "0.0(0011)", `0.0\overline{0011}_{2}`},
+		{"Fraction_ToBase_MixedHex", NewFraction(53, 2), 16, "1A.8", "1A.8_{16}"},
+		{"Fraction_ToBase_Negative", NewFraction(-1, 6), 2, "-0.0(01)", `-0.0\overline{01}_{2}`},
+		{"Fraction_ToBase_ThirdInBase3", NewFraction(1, 3), 3, "0.1", "0.1_{3}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fraction.ToBase(tt.base)
+			if err != nil {
+				t.Fatalf("ToBase() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ToBase() = %v, want %v", got, tt.want)
+			}
+			if got.LaTeX() != tt.wantLaTeX {
+				t.Errorf("LaTeX() = %v, want %v", got.LaTeX(), tt.wantLaTeX)
+			}
+
+			back, err := got.ToFraction()
+			if err != nil || !back.Equals(tt.fraction) {
+				t.Errorf("ToFraction() = %v, %v, want %v", back, err, tt.fraction)
+			}
+		})
+	}
+
+	for _, base := range []int{1, 37} {
+		if _, err := NewInteger(5).ToBase(base); !errors.Is(err, ErrInvalidBase) {
+			t.Errorf("ToBase(%d) error = %v, want %v", base, err, ErrInvalidBase)
+		}
+	}
+}
+
+func TestParseInBase(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		base  int
+		want  *Fraction
+	}{
+		{"ParseInBase_Binary", "1011", 2, NewInteger(11)},
+		{"ParseInBase_LowercaseHex", "1a.8", 16, NewFraction(53, 2)},
+		{"ParseInBase_Repeating", "0.(01)", 2, NewFraction(1, 3)},
+		{"ParseInBase_NegativeRepeating", "-10.1(10)", 2, NewFraction(-17, 6)},
+		{"ParseInBase_Octal", "0.4", 8, NewFraction(1, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInBase(tt.input, tt.base)
+			if err != nil {
+				t.Fatalf("ParseInBase() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("ParseInBase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, input := range []string{"2", "1.2.1", "0.(", "0.()", "x", "", "-", ".", "+.", " - "} {
+		if _, err := ParseInBase(input, 2); err == nil {
+			t.Errorf("ParseInBase(%q, 2) should fail", input)
+		}
+	}
+}
+
+func TestConvertBase(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		from  int
+		to    int
+		want  string
+	}{
+		{"ConvertBase_HexToBinary", "FF", 16, 2, "11111111"},
+		{"ConvertBase_BinaryToDecimal", "101.1", 2, 10, "5.5"},
+		{"ConvertBase_DecimalToTernary", "0.5", 10, 3, "0.(1)"},
+		{"ConvertBase_RepeatingDecimalToBinary", "0.(3)", 10, 2, "0.(01)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertBase(tt.input, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("ConvertBase() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ConvertBase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction_ToBaseWithSteps(t *testing.T) {
+	tests := []struct {
+		name     string
+		fraction *Fraction
+		base     int
+		want     []Step
+	}{
+		{"Fraction_ToBaseWithSteps_Integer", NewInteger(6), 2, []Step{
+			{"divide by 2", `6 \div 2 = 3 \text{ remainder } 0`},
+			{"divide by 2", `3 \div 2 = 1 \text{ remainder } 1`},
+			{"divide by 2", `1 \div 2 = 0 \text{ remainder } 1`},
+			{"read the remainders from the bottom up", "6 = 110_{2}"},
+		}},
+		{"Fraction_ToBaseWithSteps_Repeating", NewFraction(1, 3), 2, []Step{
+			{"multiply by 2, digit 0", `\dfrac{1}{3} \cdot 2 = 0 + \dfrac{2}{3}`},
+			{"multiply by 2, digit 1", `\dfrac{2}{3} \cdot 2 = 1 + \dfrac{1}{3}`},
+			{"the fractional part repeats, so the digits from there repeat", `\dfrac{1}{3}`},
+			{"read the whole-number parts from the top down", `\dfrac{1}{3} = 0.\overline{01}_{2}`},
+		}},
+		{"Fraction_ToBaseWithSteps_Mixed", NewFraction(53, 2), 16, []Step{
+			{"divide by 16", `26 \div 16 = 1 \text{ remainder } 10\ (\mathrm{A})`},
+			{"divide by 16", `1 \div 16 = 0 \text{ remainder } 1`},
+			{"read the remainders from the bottom up", "26 = 1A_{16}"},
+			{"multiply by 16, digit 8", `\dfrac{1}{2} \cdot 16 = 8`},
+			{"read the whole-number parts from the top down", `\dfrac{1}{2} = 0.8_{16}`},
+			{"combine the integer and fractional parts", `\dfrac{53}{2} = 1A.8_{16}`},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, steps, err := tt.fraction.ToBaseWithSteps(tt.base)
+			if err != nil {
+				t.Fatalf("ToBaseWithSteps() error = %v", err)
+			}
+
+			got := steps.Steps()
+			if len(got) != len(tt.want) {
+				t.Fatalf("ToBaseWithSteps() gave %d steps, want %d:\n%s", len(got), len(tt.want), steps)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("step %d = %+v, want %+v", i+1, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseInBaseWithSteps(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		base  int
+		want  []string
+	}{
+		{"ParseInBaseWithSteps_Hex", "1A.8", 16, []string{
+			`1A.8_{16} = 1 \cdot 16^{1} + 10 \cdot 16^{0} + 8 \cdot 16^{-1}`,
+			`= 16 + 10 + \dfrac{8}{16}`,
+			`= \dfrac{53}{2}`,
+		}},
+		{"ParseInBaseWithSteps_Repeating", "0.1(01)", 2, []string{
+			`0.1\overline{01}_{2} = 1 \cdot 2^{-1} + \dfrac{1}{\left(2^{2} - 1\right) \cdot 2^{1}}`,
+			`= \dfrac{1}{2} + \dfrac{1}{6}`,
+			`= \dfrac{2}{3}`,
+		}},
+		{"ParseInBaseWithSteps_LongPeriod", "0.1(010309278350515463917525773195876288659793814432989690721649484536082474226804123711340206185567)", 10, []string{
+			`0.1\overline{010309278350515463917525773195876288659793814432989690721649484536082474226804123711340206185567}_{10} = 1 \cdot 10^{-1} + \dfrac{10309278350515463917525773195876288659793814432989690721649484536082474226804123711340206185567}{\left(10^{96} - 1\right) \cdot 10^{1}}`,
+			`= \dfrac{1}{10} + \dfrac{1}{970}`,
+			`= \dfrac{49}{485}`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, steps, err := ParseInBaseWithSteps(tt.input, tt.base)
+			if err != nil {
+				t.Fatalf("ParseInBaseWithSteps() error = %v", err)
+			}
+
+			got := steps.Steps()
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseInBaseWithSteps() gave %d steps, want %d:\n%s", len(got), len(tt.want), steps)
+			}
+			for i := range tt.want {
+				if got[i].Expression != tt.want[i] {
+					t.Errorf("step %d = %v, want %v", i+1, got[i].Expression, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package basicmath
+
+import (
+	"fmt"
+	"math/big"
+	"mymath/interfaces"
+)
+
+// #region Constructor
+
+// BigFraction represents an arbitrary-precision fraction backed by math/big.Rat.
+// It is always kept in lowest terms with a positive denominator.
+//
+// BigFraction satisfies the Operable, Comparable, LaTeXer and Simplifiable interfaces, so it works with
+// generic helpers such as AddTwo, and it is a Rational, so algebra.Monomial and algebra.Polynomial
+// accept it as a coefficient.
+type BigFraction struct {
+	r *big.Rat
+}
+
+var (
+	_ interfaces.Operable[*BigFraction]   = (*BigFraction)(nil)
+	_ interfaces.Comparable[*BigFraction] = (*BigFraction)(nil)
+	_ interfaces.LaTeXer                  = (*BigFraction)(nil)
+	_ interfaces.Simplifiable             = (*BigFraction)(nil)
+)
+
+// NewBigFraction panics with ErrZeroDenominator when denominator is zero
+func NewBigFraction(numerator int, denominator int) *BigFraction {
+	if denominator == 0 {
+		panic(ErrZeroDenominator)
+	}
+
+	return &BigFraction{r: big.NewRat(int64(numerator), int64(denominator))}
+}
+
+// NewBigFractionFromBigInts panics with ErrZeroDenominator when denominator is zero
+func NewBigFractionFromBigInts(numerator *big.Int, denominator *big.Int) *BigFraction {
+	if denominator.Sign() == 0 {
+		panic(ErrZeroDenominator)
+	}
+
+	r := new(big.Rat).SetFrac(numerator, denominator)
+
+	return &BigFraction{r: r}
+}
+
+func NewBigInteger(value int) *BigFraction {
+	return &BigFraction{r: new(big.Rat).SetInt64(int64(value))}
+}
+
+// #endregion
+
+// #region Properties
+
+// Numerator returns a copy of the numerator of the fraction
+func (f *BigFraction) Numerator() *big.Int {
+	return new(big.Int).Set(f.r.Num())
+}
+
+// Denominator returns a copy of the denominator of the fraction
+func (f *BigFraction) Denominator() *big.Int {
+	return new(big.Int).Set(f.r.Denom())
+}
+
+// Rat returns a copy of the underlying big.Rat
+func (f *BigFraction) Rat() *big.Rat {
+	return new(big.Rat).Set(f.r)
+}
+
+// #endregion
+
+// #region Comparable
+
+func (f *BigFraction) Compare(other *BigFraction) int {
+	return f.r.Cmp(other.r)
+}
+
+func (f *BigFraction) Equals(other *BigFraction) bool {
+	return f.r.Cmp(other.r) == 0
+}
+
+func (f *BigFraction) GreaterThan(other *BigFraction) bool {
+	return f.r.Cmp(other.r) > 0
+}
+
+func (f *BigFraction) GreaterThanOrEqualTo(other *BigFraction) bool {
+	return f.r.Cmp(other.r) >= 0
+}
+
+func (f *BigFraction) LessThan(other *BigFraction) bool {
+	return f.r.Cmp(other.r) < 0
+}
+
+func (f *BigFraction) LessThanOrEqualTo(other *BigFraction) bool {
+	return f.r.Cmp(other.r) <= 0
+}
+
+// #endregion
+
+// #region LaTeXer
+
+func (f *BigFraction) LaTeX() string {
+	if f.r.IsInt() {
+		return f.r.Num().String()
+	}
+
+	if f.r.Sign() < 0 {
+		return fmt.Sprintf(`-\dfrac{%s}{%s}`, new(big.Int).Abs(f.r.Num()), f.r.Denom())
+	}
+
+	return fmt.Sprintf(`\dfrac{%s}{%s}`, f.r.Num(), f.r.Denom())
+}
+
+// #endregion
+
+// #region Operable
+
+func (f *BigFraction) Add(others ...*BigFraction) *BigFraction {
+	temp := new(big.Rat).Set(f.r)
+
+	for _, other := range others {
+		temp.Add(temp, other.r)
+	}
+
+	return &BigFraction{r: temp}
+}
+
+// Divide panics with ErrDivisionByZero when dividing by zero
+func (f *BigFraction) Divide(others ...*BigFraction) *BigFraction {
+	temp := new(big.Rat).Set(f.r)
+
+	for _, other := range others {
+		if other.r.Sign() == 0 {
+			panic(ErrDivisionByZero)
+		}
+		temp.Quo(temp, other.r)
+	}
+
+	return &BigFraction{r: temp}
+}
+
+func (f *BigFraction) Multiply(others ...*BigFraction) *BigFraction {
+	temp := new(big.Rat).Set(f.r)
+
+	for _, other := range others {
+		temp.Mul(temp, other.r)
+	}
+
+	return &BigFraction{r: temp}
+}
+
+func (f *BigFraction) Subtract(others ...*BigFraction) *BigFraction {
+	temp := new(big.Rat).Set(f.r)
+
+	for _, other := range others {
+		temp.Sub(temp, other.r)
+	}
+
+	return &BigFraction{r: temp}
+}
+
+// #endregion
+
+// #region Simplifiable
+
+// Simplify satisfies Simplifiable; big.Rat is always kept in lowest terms so there is nothing to do.
+func (f *BigFraction) Simplify() {}
+
+// #endregion
+
+// #region Stringer
+
+func (f *BigFraction) String() string {
+	if f.r.IsInt() {
+		return f.r.Num().String()
+	}
+
+	return f.r.String()
+}
+
+// #endregion
+
+// #region Public Methods
+
+func (f *BigFraction) Abs() *BigFraction {
+	return &BigFraction{r: new(big.Rat).Abs(f.r)}
+}
+
+func (f *BigFraction) IsInteger() bool {
+	return f.r.IsInt()
+}
+
+func (f *BigFraction) ToFloat64() float64 {
+	value, _ := f.r.Float64()
+	return value
+}
+
+// ToFraction converts back to a Fraction; ok is false if either part does not fit in an int
+func (f *BigFraction) ToFraction() (fraction *Fraction, ok bool) {
+	n, d := f.r.Num(), f.r.Denom()
+	if !n.IsInt64() || !d.IsInt64() || !fitsInInt(n.Int64()) || !fitsInInt(d.Int64()) {
+		return nil, false
+	}
+
+	return NewFraction(int(n.Int64()), int(d.Int64())), true
+}
+
+// ToBigFraction returns f itself, so that BigFraction satisfies Rational
+func (f *BigFraction) ToBigFraction() *BigFraction {
+	return f
+}
+
+// ToBigFraction converts the fraction to its arbitrary-precision form
+func (f *Fraction) ToBigFraction() *BigFraction {
+	return NewBigFraction(f.n, f.d)
+}
+
+// #endregion
+
+// #region Private Methods
+
+func fitsInInt(value int64) bool {
+	return int64(int(value)) == value
+}
+
+// #endregion
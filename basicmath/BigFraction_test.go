@@ -0,0 +1,174 @@
+package basicmath
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigFraction_Add(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial *BigFraction
+		others  []*BigFraction
+		want    string
+	}{
+		{
+			name:    "BigFraction_Add_Test01",
+			initial: NewBigFraction(1, 2),
+			others:  []*BigFraction{NewBigFraction(1, 3)},
+			want:    "5/6",
+		},
+		{
+			name:    "BigFraction_Add_Test02",
+			initial: NewBigFraction(1, math.MaxInt64),
+			others:  []*BigFraction{NewBigFraction(1, math.MaxInt64-1)},
+			want:    "18446744073709551613/85070591730234615838173535747377725442",
+		},
+		{
+			name:    "BigFraction_Add_Test03",
+			initial: NewBigFraction(3, 4),
+			others:  []*BigFraction{NewBigFraction(1, 4)},
+			want:    "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.initial.Add(tt.others...); got.String() != tt.want {
+				t.Errorf("BigFraction.Add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBigFraction_Operations(t *testing.T) {
+	a := NewBigFraction(2, 3)
+	b := NewBigFraction(-5, 6)
+
+	tests := []struct {
+		name string
+		got  *BigFraction
+		want string
+	}{
+		{name: "BigFraction_Subtract_Test01", got: a.Subtract(b), want: "3/2"},
+		{name: "BigFraction_Multiply_Test01", got: a.Multiply(b), want: "-5/9"},
+		{name: "BigFraction_Divide_Test01", got: a.Divide(b), want: "-4/5"},
+		{name: "BigFraction_Abs_Test01", got: b.Abs(), want: "5/6"},
+		{name: "BigFraction_AddTwo_Test01", got: AddTwo(a, b), want: "-1/6"},
+		{name: "BigFraction_DivideTwo_Test01", got: DivideTwo(a, b), want: "-4/5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBigFraction_Compare(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(1), 100)
+
+	tests := []struct {
+		name string
+		a    *BigFraction
+		b    *BigFraction
+		want int
+	}{
+		{
+			name: "BigFraction_Compare_Test01",
+			a:    NewBigFraction(1, 3),
+			b:    NewBigFraction(1, 2),
+			want: -1,
+		},
+		{
+			name: "BigFraction_Compare_Test02",
+			a:    NewBigFractionFromBigInts(huge, big.NewInt(3)),
+			b:    NewBigFractionFromBigInts(huge, big.NewInt(4)),
+			want: 1,
+		},
+		{
+			name: "BigFraction_Compare_Test03",
+			a:    NewBigFraction(4, 8),
+			b:    NewBigFraction(1, 2),
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Compare(tt.b); got != tt.want {
+				t.Errorf("BigFraction.Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBigFraction_LaTeX(t *testing.T) {
+	tests := []struct {
+		name string
+		f    *BigFraction
+		want string
+	}{
+		{name: "BigFraction_LaTeX_Test01", f: NewBigFraction(3, 4), want: `\dfrac{3}{4}`},
+		{name: "BigFraction_LaTeX_Test02", f: NewBigFraction(-3, 4), want: `-\dfrac{3}{4}`},
+		{name: "BigFraction_LaTeX_Test03", f: NewBigFraction(8, 4), want: "2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.LaTeX(); got != tt.want {
+				t.Errorf("BigFraction.LaTeX() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBigFraction_ToFraction(t *testing.T) {
+	f, ok := NewBigFraction(6, 8).ToFraction()
+	if !ok || !f.Equals(NewFraction(3, 4)) {
+		t.Errorf("BigFraction.ToFraction() = %v, %v, want 3/4, true", f, ok)
+	}
+
+	huge := new(big.Int).Lsh(big.NewInt(1), 80)
+	if _, ok := NewBigFractionFromBigInts(huge, big.NewInt(3)).ToFraction(); ok {
+		t.Errorf("BigFraction.ToFraction() ok = true, want false for values outside int range")
+	}
+
+	if got := NewFraction(-2, 5).ToBigFraction(); got.String() != "-2/5" {
+		t.Errorf("Fraction.ToBigFraction() = %v, want -2/5", got)
+	}
+}
+
+func TestFraction_Compare_LargeValues(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *Fraction
+		b    *Fraction
+		want int
+	}{
+		{
+			name: "Fraction_Compare_Overflow_Test01",
+			a:    NewFraction(math.MaxInt64-1, math.MaxInt64),
+			b:    NewFraction(math.MaxInt64-2, math.MaxInt64-1),
+			want: 1,
+		},
+		{
+			name: "Fraction_Compare_Overflow_Test02",
+			a:    NewFraction(1, math.MaxInt64),
+			b:    NewFraction(1, math.MaxInt64-1),
+			want: -1,
+		},
+		{
+			name: "Fraction_Compare_Overflow_Test03",
+			a:    NewFraction(math.MaxInt64, math.MaxInt64),
+			b:    NewInteger(1),
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Compare(tt.b); got != tt.want {
+				t.Errorf("Fraction.Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
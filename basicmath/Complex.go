@@ -0,0 +1,353 @@
+package basicmath
+
+import (
+	"fmt"
+	"math"
+	"mymath/interfaces"
+	"mymath/latex"
+)
+
+// #region Constructor
+
+// Complex is an exact complex number a + bi with rational real and imaginary parts.
+// Complex numbers have no ordering, so only Equals is provided for comparison.
+type Complex struct {
+	real      *Fraction
+	imaginary *Fraction
+}
+
+var (
+	_ interfaces.Operable[*Complex] = (*Complex)(nil)
+	_ interfaces.LaTeXer            = (*Complex)(nil)
+)
+
+func NewComplex(real *Fraction, imaginary *Fraction) *Complex {
+	return &Complex{real: real.simplifiedCopy(), imaginary: imaginary.simplifiedCopy()}
+}
+
+// NewComplexFromInts builds real + imaginary·i from integers
+func NewComplexFromInts(real int, imaginary int) *Complex {
+	return NewComplex(NewInteger(real), NewInteger(imaginary))
+}
+
+// NewImaginaryUnit returns i
+func NewImaginaryUnit() *Complex {
+	return NewComplexFromInts(0, 1)
+}
+
+// ToComplex returns the fraction as a complex number with no imaginary part
+func (f *Fraction) ToComplex() *Complex {
+	return NewComplex(f, NewInteger(0))
+}
+
+// #endregion
+
+// #region Properties
+
+func (c *Complex) Real() *Fraction {
+	return NewFraction(c.real.n, c.real.d)
+}
+
+func (c *Complex) Imaginary() *Fraction {
+	return NewFraction(c.imaginary.n, c.imaginary.d)
+}
+
+// #endregion
+
+// #region Comparable
+
+func (c *Complex) Equals(other *Complex) bool {
+	return c.real.Equals(other.real) && c.imaginary.Equals(other.imaginary)
+}
+
+// #endregion
+
+// #region LaTeXer
+
+// LaTeX renders the number in a + bi form, e.g. 3 - \dfrac{1}{2}i
+func (c *Complex) LaTeX() string {
+	if c.imaginary.n == 0 {
+		return c.real.LaTeX()
+	}
+
+	imaginary := imaginaryTerm(c.imaginary, c.imaginary.LaTeX())
+	if c.real.n == 0 {
+		return imaginary
+	}
+
+	return latex.ConnectWithPlusSign(c.real.LaTeX(), imaginary)
+}
+
+// #endregion
+
+// #region Operable
+
+// Add panics with ErrOverflow if a part does not fit in an int; see TryAdd.
+func (c *Complex) Add(others ...*Complex) *Complex {
+	return mustComplex(c.TryAdd(others...))
+}
+
+// Divide panics with ErrDivisionByZero or ErrOverflow; see TryDivide.
+func (c *Complex) Divide(others ...*Complex) *Complex {
+	return mustComplex(c.TryDivide(others...))
+}
+
+// Multiply panics with ErrOverflow if a part does not fit in an int; see TryMultiply.
+func (c *Complex) Multiply(others ...*Complex) *Complex {
+	return mustComplex(c.TryMultiply(others...))
+}
+
+// Subtract panics with ErrOverflow if a part does not fit in an int; see TrySubtract.
+func (c *Complex) Subtract(others ...*Complex) *Complex {
+	return mustComplex(c.TrySubtract(others...))
+}
+
+func (c *Complex) TryAdd(others ...*Complex) (*Complex, error) {
+	temp := c.copy()
+
+	for _, other := range others {
+		real, err := temp.real.TryAdd(other.real)
+		if err != nil {
+			return nil, err
+		}
+		imaginary, err := temp.imaginary.TryAdd(other.imaginary)
+		if err != nil {
+			return nil, err
+		}
+		temp = &Complex{real: real, imaginary: imaginary}
+	}
+
+	return temp, nil
+}
+
+// TryDivide multiplies by the conjugate of each divisor: (a + bi)/(c + di) = (a + bi)(c - di)/(c² + d²)
+func (c *Complex) TryDivide(others ...*Complex) (*Complex, error) {
+	temp := c.copy()
+
+	for _, other := range others {
+		modulusSquared, err := other.TryModulusSquared()
+		if err != nil {
+			return nil, err
+		}
+		if modulusSquared.n == 0 {
+			return nil, ErrDivisionByZero
+		}
+
+		numerator, err := temp.TryMultiply(other.Conjugate())
+		if err != nil {
+			return nil, err
+		}
+
+		real, err := numerator.real.TryDivide(modulusSquared)
+		if err != nil {
+			return nil, err
+		}
+		imaginary, err := numerator.imaginary.TryDivide(modulusSquared)
+		if err != nil {
+			return nil, err
+		}
+		temp = &Complex{real: real, imaginary: imaginary}
+	}
+
+	return temp, nil
+}
+
+// TryMultiply uses (a + bi)(c + di) = (ac - bd) + (ad + bc)i
+func (c *Complex) TryMultiply(others ...*Complex) (*Complex, error) {
+	temp := c.copy()
+
+	for _, other := range others {
+		ac, err := temp.real.TryMultiply(other.real)
+		if err != nil {
+			return nil, err
+		}
+		bd, err := temp.imaginary.TryMultiply(other.imaginary)
+		if err != nil {
+			return nil, err
+		}
+		ad, err := temp.real.TryMultiply(other.imaginary)
+		if err != nil {
+			return nil, err
+		}
+		bc, err := temp.imaginary.TryMultiply(other.real)
+		if err != nil {
+			return nil, err
+		}
+
+		real, err := ac.TrySubtract(bd)
+		if err != nil {
+			return nil, err
+		}
+		imaginary, err := ad.TryAdd(bc)
+		if err != nil {
+			return nil, err
+		}
+		temp = &Complex{real: real, imaginary: imaginary}
+	}
+
+	return temp, nil
+}
+
+func (c *Complex) TrySubtract(others ...*Complex) (*Complex, error) {
+	temp := c.copy()
+
+	for _, other := range others {
+		real, err := temp.real.TrySubtract(other.real)
+		if err != nil {
+			return nil, err
+		}
+		imaginary, err := temp.imaginary.TrySubtract(other.imaginary)
+		if err != nil {
+			return nil, err
+		}
+		temp = &Complex{real: real, imaginary: imaginary}
+	}
+
+	return temp, nil
+}
+
+// #endregion
+
+// #region Stringer
+
+// String renders the number in a + bi form, e.g. 3 - 2i or (1/2)i
+func (c *Complex) String() string {
+	if c.imaginary.n == 0 {
+		return c.real.String()
+	}
+
+	coefficient := c.imaginary.String()
+	if c.imaginary.d != 1 {
+		coefficient = fmt.Sprintf("(%s)", NewFraction(Abs(c.imaginary.n), c.imaginary.d))
+		if c.imaginary.n < 0 {
+			coefficient = "-" + coefficient
+		}
+	}
+
+	imaginary := imaginaryTerm(c.imaginary, coefficient)
+	if c.real.n == 0 {
+		return imaginary
+	}
+
+	return latex.ConnectWithPlusSign(c.real.String(), imaginary)
+}
+
+// #endregion
+
+// #region Public Methods
+
+// Conjugate returns a - bi
+func (c *Complex) Conjugate() *Complex {
+	return &Complex{real: c.Real(), imaginary: NewFraction(-c.imaginary.n, c.imaginary.d)}
+}
+
+// ModulusSquared returns |z|² = a² + b², which is always rational; it panics with ErrOverflow, see TryModulusSquared.
+func (c *Complex) ModulusSquared() *Fraction {
+	return mustFraction(c.TryModulusSquared())
+}
+
+func (c *Complex) TryModulusSquared() (*Fraction, error) {
+	realSquared, err := c.real.TryMultiply(c.real)
+	if err != nil {
+		return nil, err
+	}
+	imaginarySquared, err := c.imaginary.TryMultiply(c.imaginary)
+	if err != nil {
+		return nil, err
+	}
+
+	return realSquared.TryAdd(imaginarySquared)
+}
+
+// Modulus returns |z| exactly as a radical, e.g. |1 + i| = √2
+func (c *Complex) Modulus() (*Radical, error) {
+	modulusSquared, err := c.TryModulusSquared()
+	if err != nil {
+		return nil, err
+	}
+
+	return modulusSquared.Root(2)
+}
+
+func (c *Complex) IsReal() bool {
+	return c.imaginary.n == 0
+}
+
+func (c *Complex) IsZero() bool {
+	return c.real.n == 0 && c.imaginary.n == 0
+}
+
+// Pow raises the number to an integer power; negative exponents use the reciprocal.
+// It panics with ErrDivisionByZero or ErrOverflow; see TryPow.
+func (c *Complex) Pow(exponent int) *Complex {
+	return mustComplex(c.TryPow(exponent))
+}
+
+// TryPow raises the number to an integer power using repeated squaring, e.g. (1 + i)^2 = 2i
+func (c *Complex) TryPow(exponent int) (*Complex, error) {
+	if exponent == math.MinInt {
+		// -exponent does not fit, so square the power for half the exponent
+		half, err := c.TryPow(exponent / 2)
+		if err != nil {
+			return nil, err
+		}
+		return half.TryMultiply(half)
+	}
+
+	base := c.copy()
+	if exponent < 0 {
+		reciprocal, err := NewComplexFromInts(1, 0).TryDivide(base)
+		if err != nil {
+			return nil, err
+		}
+		base = reciprocal
+		exponent = -exponent
+	}
+
+	result := NewComplexFromInts(1, 0)
+	for exponent > 0 {
+		var err error
+		if exponent&1 == 1 {
+			if result, err = result.TryMultiply(base); err != nil {
+				return nil, err
+			}
+		}
+		exponent >>= 1
+		if exponent > 0 {
+			if base, err = base.TryMultiply(base); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+func mustComplex(c *Complex, err error) *Complex {
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+func (c *Complex) copy() *Complex {
+	return &Complex{real: c.Real(), imaginary: c.Imaginary()}
+}
+
+// writes bi, dropping a coefficient of 1 or -1
+func imaginaryTerm(imaginary *Fraction, coefficient string) string {
+	switch {
+	case imaginary.n == 1 && imaginary.d == 1:
+		return "i"
+	case imaginary.n == -1 && imaginary.d == 1:
+		return "-i"
+	}
+	return coefficient + "i"
+}
+
+// #endregion
@@ -0,0 +1,136 @@
+package basicmath
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestComplex_Operations(t *testing.T) {
+	a := NewComplexFromInts(3, 2)
+	b := NewComplexFromInts(1, -4)
+
+	tests := []struct {
+		name string
+		got  *Complex
+		want *Complex
+	}{
+		{name: "Complex_Add", got: a.Add(b), want: NewComplexFromInts(4, -2)},
+		{name: "Complex_Subtract", got: a.Subtract(b), want: NewComplexFromInts(2, 6)},
+		{name: "Complex_Multiply", got: a.Multiply(b), want: NewComplexFromInts(11, -10)},
+		{name: "Complex_Divide", got: a.Divide(b), want: NewComplex(NewFraction(-5, 17), NewFraction(14, 17))},
+		{name: "Complex_Conjugate", got: a.Conjugate(), want: NewComplexFromInts(3, -2)},
+		{name: "Complex_ISquared", got: NewImaginaryUnit().Multiply(NewImaginaryUnit()), want: NewComplexFromInts(-1, 0)},
+		{name: "Complex_Pow", got: NewComplexFromInts(1, 1).Pow(4), want: NewComplexFromInts(-4, 0)},
+		{name: "Complex_PowNegative", got: NewImaginaryUnit().Pow(-1), want: NewComplexFromInts(0, -1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equals(tt.want) {
+				t.Errorf("got %s, want %s", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComplex_ModulusSquared(t *testing.T) {
+	z := NewComplex(NewFraction(1, 2), NewFraction(-3, 4))
+	if got := z.ModulusSquared(); !got.Equals(NewFraction(13, 16)) {
+		t.Errorf("|%s|² = %s, want 13/16", z, got)
+	}
+
+	modulus, err := NewComplexFromInts(3, 4).Modulus()
+	if err != nil || modulus.String() != "5" {
+		t.Errorf("|3 + 4i| = %v, %v, want 5", modulus, err)
+	}
+
+	modulus, err = NewComplexFromInts(1, 1).Modulus()
+	if err != nil || modulus.String() != "√2" {
+		t.Errorf("|1 + i| = %v, %v, want √2", modulus, err)
+	}
+}
+
+func TestComplex_DivideByZero(t *testing.T) {
+	if _, err := NewComplexFromInts(1, 1).TryDivide(NewComplexFromInts(0, 0)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("TryDivide() error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestComplex_Equals(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *Complex
+		b    *Complex
+		want bool
+	}{
+		{name: "Complex_Equals_Unsimplified", a: NewComplexFromInts(2, 1), b: NewComplex(NewFraction(4, 2), NewInteger(1)), want: true},
+		{name: "Complex_Equals_DifferentImaginary", a: NewComplexFromInts(2, 1), b: NewComplexFromInts(2, -1), want: false},
+		{name: "Complex_Equals_DifferentReal", a: NewComplexFromInts(1, 5), b: NewComplexFromInts(2, 5), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equals(tt.b); got != tt.want {
+				t.Errorf("(%s).Equals(%s) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComplex_TryPow(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        *Complex
+		exponent int
+		want     *Complex
+	}{
+		{name: "Complex_TryPow_Square", c: NewComplexFromInts(1, 1), exponent: 2, want: NewComplexFromInts(0, 2)},
+		{name: "Complex_TryPow_Zero", c: NewComplexFromInts(3, 4), exponent: 0, want: NewComplexFromInts(1, 0)},
+		{name: "Complex_TryPow_Negative", c: NewComplexFromInts(1, 1), exponent: -2, want: NewComplex(NewInteger(0), NewFraction(-1, 2))},
+		{name: "Complex_TryPow_HugeUnit", c: NewImaginaryUnit(), exponent: math.MaxInt, want: NewComplexFromInts(0, -1)},
+		{name: "Complex_TryPow_MinInt", c: NewImaginaryUnit(), exponent: math.MinInt, want: NewComplexFromInts(1, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.TryPow(tt.exponent)
+			if err != nil {
+				t.Fatalf("TryPow() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("(%s)^%d = %s, want %s", tt.c, tt.exponent, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewComplexFromInts(0, 0).TryPow(-1); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("0^-1 error = %v, want %v", err, ErrDivisionByZero)
+	}
+	if _, err := NewComplexFromInts(2, 2).TryPow(1 << 40); !errors.Is(err, ErrOverflow) {
+		t.Errorf("(2 + 2i)^(2^40) error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestComplex_Format(t *testing.T) {
+	tests := []struct {
+		name      string
+		z         *Complex
+		want      string
+		wantLaTeX string
+	}{
+		{name: "Complex_Format_Full", z: NewComplexFromInts(3, -2), want: "3 - 2i", wantLaTeX: "3 - 2i"},
+		{name: "Complex_Format_Real", z: NewComplexFromInts(-5, 0), want: "-5", wantLaTeX: "-5"},
+		{name: "Complex_Format_Imaginary", z: NewComplexFromInts(0, -1), want: "-i", wantLaTeX: "-i"},
+		{name: "Complex_Format_Unit", z: NewComplexFromInts(2, 1), want: "2 + i", wantLaTeX: "2 + i"},
+		{name: "Complex_Format_Fraction", z: NewComplex(NewFraction(1, 2), NewFraction(-3, 4)), want: "1/2 - (3/4)i", wantLaTeX: `\dfrac{1}{2} - \dfrac{3}{4}i`},
+		{name: "Complex_Format_Zero", z: NewComplexFromInts(0, 0), want: "0", wantLaTeX: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.z.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			if got := tt.z.LaTeX(); got != tt.wantLaTeX {
+				t.Errorf("LaTeX() = %q, want %q", got, tt.wantLaTeX)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package basicmath
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// DefaultFloatTolerance is the absolute error allowed when FromFloatToFraction converts a float64.
+// Values smaller than 0.001 use it as a relative error instead so they are not rounded away to zero.
+const DefaultFloatTolerance = 1e-6
+
+// #region Public Methods
+
+// ApproximateFloat returns the closest fraction to value whose denominator is at most maxDenominator
+func ApproximateFloat(value float64, maxDenominator int) (*Fraction, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, ErrNotFinite
+	}
+	if maxDenominator < 1 {
+		return nil, fmt.Errorf("basicmath: maxDenominator must be positive, got %d", maxDenominator)
+	}
+
+	exact := new(big.Rat).SetFloat64(value)
+	limit := big.NewInt(int64(maxDenominator))
+
+	if exact.Denom().Cmp(limit) <= 0 {
+		return ratToFraction(exact)
+	}
+
+	p0, q0, p1, q1 := big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(0)
+	n, d := new(big.Int).Set(exact.Num()), new(big.Int).Set(exact.Denom())
+
+	for {
+		a := floorDiv(n, d)
+		q2 := new(big.Int).Add(q0, new(big.Int).Mul(a, q1))
+		if q2.Cmp(limit) > 0 {
+			break
+		}
+
+		p0, q0, p1, q1 = p1, q1, new(big.Int).Add(p0, new(big.Int).Mul(a, p1)), q2
+		n, d = d, new(big.Int).Sub(n, new(big.Int).Mul(a, d))
+	}
+
+	// the best approximation is either the last convergent or the largest semiconvergent that fits
+	k := new(big.Int).Quo(new(big.Int).Sub(limit, q0), q1)
+	semi := new(big.Rat).SetFrac(
+		new(big.Int).Add(p0, new(big.Int).Mul(k, p1)),
+		new(big.Int).Add(q0, new(big.Int).Mul(k, q1)))
+	convergent := new(big.Rat).SetFrac(p1, q1)
+
+	semiError := new(big.Rat).Abs(new(big.Rat).Sub(semi, exact))
+	convergentError := new(big.Rat).Abs(new(big.Rat).Sub(convergent, exact))
+
+	if convergentError.Cmp(semiError) <= 0 {
+		return ratToFraction(convergent)
+	}
+
+	return ratToFraction(semi)
+}
+
+// ApproximateFloatWithTolerance returns the fraction with the smallest denominator within tolerance of value
+func ApproximateFloatWithTolerance(value float64, tolerance float64) (*Fraction, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, ErrNotFinite
+	}
+	if math.IsNaN(tolerance) || tolerance < 0 {
+		return nil, fmt.Errorf("basicmath: tolerance must be non-negative, got %v", tolerance)
+	}
+
+	exact := new(big.Rat).SetFloat64(value)
+	tol := new(big.Rat).SetFloat64(tolerance)
+
+	within := func(p, q *big.Int) bool {
+		diff := new(big.Rat).Sub(new(big.Rat).SetFrac(p, q), exact)
+		return diff.Abs(diff).Cmp(tol) <= 0
+	}
+
+	p0, q0, p1, q1 := big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(0)
+	n, d := new(big.Int).Set(exact.Num()), new(big.Int).Set(exact.Denom())
+
+	for d.Sign() != 0 {
+		a := floorDiv(n, d)
+
+		// semiconvergents (p0 + k*p1)/(q0 + k*q1) approach the value monotonically,
+		// so binary search for the smallest k that is close enough
+		if q1.Sign() != 0 {
+			low, high := big.NewInt(1), new(big.Int).Set(a)
+			for low.Cmp(high) < 0 {
+				mid := new(big.Int).Rsh(new(big.Int).Add(low, high), 1)
+				if within(new(big.Int).Add(p0, new(big.Int).Mul(mid, p1)), new(big.Int).Add(q0, new(big.Int).Mul(mid, q1))) {
+					high = mid
+				} else {
+					low = mid.Add(mid, big.NewInt(1))
+				}
+			}
+
+			p := new(big.Int).Add(p0, new(big.Int).Mul(low, p1))
+			q := new(big.Int).Add(q0, new(big.Int).Mul(low, q1))
+			if within(p, q) {
+				return ratToFraction(new(big.Rat).SetFrac(p, q))
+			}
+		}
+
+		p0, q0, p1, q1 = p1, q1, new(big.Int).Add(p0, new(big.Int).Mul(a, p1)), new(big.Int).Add(q0, new(big.Int).Mul(a, q1))
+		n, d = d, new(big.Int).Sub(n, new(big.Int).Mul(a, d))
+
+		if within(p1, q1) {
+			return ratToFraction(new(big.Rat).SetFrac(p1, q1))
+		}
+	}
+
+	return ratToFraction(exact)
+}
+
+// ContinuedFraction returns the simple continued fraction expansion [a0; a1, a2, ...] of the fraction
+func (f *Fraction) ContinuedFraction() []int {
+	terms := []int{}
+	n, d := f.n, f.d
+
+	for d != 0 {
+		a := n / d
+		if n%d != 0 && (n < 0) != (d < 0) {
+			a--
+		}
+
+		terms = append(terms, a)
+		n, d = d, n-a*d
+	}
+
+	return terms
+}
+
+// ContinuedFractionLaTeX renders the expansion as nested \cfrac expressions
+func ContinuedFractionLaTeX(terms ...int) string {
+	if len(terms) == 0 {
+		return ""
+	}
+
+	if len(terms) == 1 {
+		return fmt.Sprintf("%d", terms[0])
+	}
+
+	return fmt.Sprintf(`%d + \cfrac{1}{%s}`, terms[0], ContinuedFractionLaTeX(terms[1:]...))
+}
+
+// ContinuedFractionString renders the expansion in bracket notation, e.g. [2; 1, 3]
+func ContinuedFractionString(terms ...int) string {
+	if len(terms) == 0 {
+		return "[]"
+	}
+
+	rest := make([]string, 0, len(terms)-1)
+	for _, term := range terms[1:] {
+		rest = append(rest, fmt.Sprintf("%d", term))
+	}
+
+	if len(rest) == 0 {
+		return fmt.Sprintf("[%d]", terms[0])
+	}
+
+	return fmt.Sprintf("[%d; %s]", terms[0], strings.Join(rest, ", "))
+}
+
+// Convergents returns the successive convergents h/k of a continued fraction expansion
+func Convergents(terms ...int) ([]*Fraction, error) {
+	convergents := []*Fraction{}
+	h0, k0, h1, k1 := 0, 1, 1, 0
+
+	for i, a := range terms {
+		if i > 0 && a <= 0 {
+			return nil, fmt.Errorf("basicmath: continued fraction term %d must be positive, got %d", i, a)
+		}
+
+		ah, hOk := multiplyInts(a, h1)
+		ak, kOk := multiplyInts(a, k1)
+		h2, hSumOk := addInts(ah, h0)
+		k2, kSumOk := addInts(ak, k0)
+		if !hOk || !kOk || !hSumOk || !kSumOk {
+			return nil, ErrOverflow
+		}
+
+		h0, k0, h1, k1 = h1, k1, h2, k2
+		convergents = append(convergents, NewFraction(h1, k1))
+	}
+
+	return convergents, nil
+}
+
+// FloatContinuedFraction returns up to maxTerms terms of the continued fraction expansion of value
+func FloatContinuedFraction(value float64, maxTerms int) ([]int, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, ErrNotFinite
+	}
+
+	terms := []int{}
+	exact := new(big.Rat).SetFloat64(value)
+	n, d := new(big.Int).Set(exact.Num()), new(big.Int).Set(exact.Denom())
+
+	for len(terms) < maxTerms && d.Sign() != 0 {
+		a := floorDiv(n, d)
+		if !a.IsInt64() || !fitsInInt(a.Int64()) {
+			return nil, ErrOverflow
+		}
+
+		terms = append(terms, int(a.Int64()))
+		n, d = d, new(big.Int).Sub(n, new(big.Int).Mul(a, d))
+	}
+
+	return terms, nil
+}
+
+// FromContinuedFraction evaluates a continued fraction expansion [a0; a1, a2, ...]
+func FromContinuedFraction(terms ...int) (*Fraction, error) {
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("basicmath: continued fraction has no terms")
+	}
+
+	convergents, err := Convergents(terms...)
+	if err != nil {
+		return nil, err
+	}
+
+	return convergents[len(convergents)-1], nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+// floor of n/d; the divisor is always positive here, where Euclidean division rounds down
+func floorDiv(n, d *big.Int) *big.Int {
+	return new(big.Int).Div(n, d)
+}
+
+func ratToFraction(r *big.Rat) (*Fraction, error) {
+	f, ok := (&BigFraction{r: r}).ToFraction()
+	if !ok {
+		return nil, ErrOverflow
+	}
+
+	return f, nil
+}
+
+// #endregion
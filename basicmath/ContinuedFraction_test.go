@@ -0,0 +1,146 @@
+package basicmath
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestFromFloatToFraction(t *testing.T) {
+	tests := []struct {
+		name  string
+		value float64
+		want  *Fraction
+	}{
+		{name: "Fraction_FromFloatToFraction_Test01", value: 0.5, want: NewFraction(1, 2)},
+		{name: "Fraction_FromFloatToFraction_Test02", value: -0.5, want: NewFraction(-1, 2)},
+		{name: "Fraction_FromFloatToFraction_Test03", value: 0.333333, want: NewFraction(1, 3)},
+		{name: "Fraction_FromFloatToFraction_Test04", value: -2.75, want: NewFraction(-11, 4)},
+		{name: "Fraction_FromFloatToFraction_Test05", value: 0.1, want: NewFraction(1, 10)},
+		{name: "Fraction_FromFloatToFraction_Test06", value: 42, want: NewInteger(42)},
+		{name: "Fraction_FromFloatToFraction_Test07", value: 0.00002, want: NewFraction(1, 50000)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromFloatToFraction(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromFloatToFraction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApproximateFloat(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          float64
+		maxDenominator int
+		want           *Fraction
+		wantErr        bool
+	}{
+		{name: "Fraction_ApproximateFloat_Pi7", value: math.Pi, maxDenominator: 7, want: NewFraction(22, 7)},
+		{name: "Fraction_ApproximateFloat_Pi100", value: math.Pi, maxDenominator: 100, want: NewFraction(311, 99)},
+		{name: "Fraction_ApproximateFloat_Pi1000", value: math.Pi, maxDenominator: 1000, want: NewFraction(355, 113)},
+		{name: "Fraction_ApproximateFloat_Negative", value: -0.3333, maxDenominator: 10, want: NewFraction(-1, 3)},
+		{name: "Fraction_ApproximateFloat_Exact", value: 0.375, maxDenominator: 8, want: NewFraction(3, 8)},
+		{name: "Fraction_ApproximateFloat_NaN", value: math.NaN(), maxDenominator: 8, wantErr: true},
+		{name: "Fraction_ApproximateFloat_BadLimit", value: 0.5, maxDenominator: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApproximateFloat(tt.value, tt.maxDenominator)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApproximateFloat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApproximateFloat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApproximateFloatWithTolerance(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     float64
+		tolerance float64
+		want      *Fraction
+	}{
+		{name: "Fraction_ApproximateFloatWithTolerance_Test01", value: math.Pi, tolerance: 0.01, want: NewFraction(22, 7)},
+		{name: "Fraction_ApproximateFloatWithTolerance_Test02", value: math.Pi, tolerance: 1e-6, want: NewFraction(355, 113)},
+		{name: "Fraction_ApproximateFloatWithTolerance_Test03", value: 0.1428, tolerance: 1e-3, want: NewFraction(1, 7)},
+		{name: "Fraction_ApproximateFloatWithTolerance_Test04", value: 0.75, tolerance: 0, want: NewFraction(3, 4)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApproximateFloatWithTolerance(tt.value, tt.tolerance)
+			if err != nil {
+				t.Fatalf("ApproximateFloatWithTolerance() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApproximateFloatWithTolerance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ApproximateFloatWithTolerance(math.Inf(1), 0.1); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("ApproximateFloatWithTolerance() error = %v, want %v", err, ErrNotFinite)
+	}
+}
+
+func TestFraction_ContinuedFraction(t *testing.T) {
+	tests := []struct {
+		name string
+		f    *Fraction
+		want []int
+	}{
+		{name: "Fraction_ContinuedFraction_Test01", f: NewFraction(415, 93), want: []int{4, 2, 6, 7}},
+		{name: "Fraction_ContinuedFraction_Test02", f: NewFraction(-7, 3), want: []int{-3, 1, 2}},
+		{name: "Fraction_ContinuedFraction_Test03", f: NewInteger(5), want: []int{5}},
+		{name: "Fraction_ContinuedFraction_Test04", f: NewFraction(3, 8), want: []int{0, 2, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.f.ContinuedFraction()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fraction.ContinuedFraction() = %v, want %v", got, tt.want)
+			}
+
+			back, err := FromContinuedFraction(got...)
+			if err != nil || !back.Equals(tt.f) {
+				t.Errorf("FromContinuedFraction() = %v, %v, want %v", back, err, tt.f)
+			}
+		})
+	}
+}
+
+func TestConvergents(t *testing.T) {
+	got, err := Convergents(4, 2, 6, 7)
+	want := []*Fraction{NewInteger(4), NewFraction(9, 2), NewFraction(58, 13), NewFraction(415, 93)}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Convergents() = %v, %v, want %v", got, err, want)
+	}
+
+	if _, err := Convergents(1, 0, 2); err == nil {
+		t.Errorf("Convergents() expected an error for a non-positive term")
+	}
+}
+
+func TestFloatContinuedFraction(t *testing.T) {
+	got, err := FloatContinuedFraction(math.Pi, 5)
+	want := []int{3, 7, 15, 1, 292}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("FloatContinuedFraction() = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestContinuedFractionFormatting(t *testing.T) {
+	if got, want := ContinuedFractionString(4, 2, 6, 7), "[4; 2, 6, 7]"; got != want {
+		t.Errorf("ContinuedFractionString() = %v, want %v", got, want)
+	}
+
+	if got, want := ContinuedFractionLaTeX(1, 2, 3), `1 + \cfrac{1}{2 + \cfrac{1}{3}}`; got != want {
+		t.Errorf("ContinuedFractionLaTeX() = %v, want %v", got, want)
+	}
+}
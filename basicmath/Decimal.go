@@ -0,0 +1,460 @@
+package basicmath
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"mymath/interfaces"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode chooses how a value is rounded to fewer digits
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest digit and a tie away from zero, e.g. 2.5 → 3 and -2.5 → -3
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest digit and a tie to the even digit, e.g. 2.5 → 2 and 3.5 → 4
+	RoundHalfEven
+	// RoundTruncate drops the extra digits, rounding toward zero, e.g. 2.9 → 2 and -2.9 → -2
+	RoundTruncate
+	// RoundCeiling rounds toward positive infinity, e.g. 2.1 → 3 and -2.9 → -2
+	RoundCeiling
+)
+
+// #region Constructor
+
+// Decimal is an exact base-10 number unscaled × 10^-scale, e.g. 12.50 has unscaled 1250 and scale 2.
+// The scale is kept as given, so trailing zeros such as the cents in 12.50 are preserved.
+type Decimal struct {
+	unscaled int
+	scale    int
+}
+
+var (
+	_ interfaces.Operable[*Decimal]   = (*Decimal)(nil)
+	_ interfaces.Comparable[*Decimal] = (*Decimal)(nil)
+	_ interfaces.LaTeXer              = (*Decimal)(nil)
+)
+
+// NewDecimal builds unscaled × 10^-scale; a negative scale multiplies by a power of ten.
+// It panics with ErrOverflow, see NewDecimalE.
+func NewDecimal(unscaled int, scale int) *Decimal {
+	return mustDecimal(NewDecimalE(unscaled, scale))
+}
+
+func NewDecimalE(unscaled int, scale int) (*Decimal, error) {
+	if scale < 0 {
+		factor, ok := powInt(10, -scale)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		value, ok := multiplyInts(unscaled, factor)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		unscaled, scale = value, 0
+	}
+
+	if _, ok := powInt(10, scale); !ok {
+		return nil, ErrOverflow
+	}
+
+	return &Decimal{unscaled: unscaled, scale: scale}, nil
+}
+
+// ParseDecimal reads a decimal such as "12.50", "-0.05", ".5", "1.2e3" or "6.02E-5"
+func ParseDecimal(s string) (*Decimal, error) {
+	text := strings.TrimSpace(strings.ReplaceAll(s, "−", "-"))
+
+	mantissa, exponentText, scientific := strings.Cut(strings.ToLower(text), "e")
+	exponent := 0
+	if scientific {
+		var err error
+		exponent, err = strconv.Atoi(exponentText)
+		if err != nil {
+			return nil, fmt.Errorf("basicmath: invalid decimal %q", s)
+		}
+	}
+
+	digits, places, err := parseDecimalDigits(mantissa)
+	if err != nil || strings.Trim(mantissa, "+-.") == "" {
+		return nil, fmt.Errorf("basicmath: invalid decimal %q", s)
+	}
+
+	unscaled, err := strconv.Atoi(digits)
+	if err != nil {
+		return nil, ErrOverflow
+	}
+
+	return NewDecimalE(unscaled, places-exponent)
+}
+
+// ToDecimal rounds the fraction to places decimal places, e.g. 2/3 to 2 places is 0.67 with RoundHalfUp
+func (f *Fraction) ToDecimal(places int, mode RoundingMode) (*Decimal, error) {
+	if places < 0 {
+		return nil, fmt.Errorf("basicmath: decimal places must not be negative, got %d", places)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	numerator := new(big.Int).Mul(big.NewInt(int64(f.n)), scale)
+	denominator := big.NewInt(int64(f.d))
+	if denominator.Sign() < 0 {
+		numerator.Neg(numerator)
+		denominator.Neg(denominator)
+	}
+
+	return decimalFromBig(roundQuotient(numerator, denominator, mode), places)
+}
+
+// #endregion
+
+// #region Properties
+
+func (d *Decimal) Unscaled() int {
+	return d.unscaled
+}
+
+// Scale is the number of digits after the decimal point
+func (d *Decimal) Scale() int {
+	return d.scale
+}
+
+// #endregion
+
+// #region Comparable
+
+func (d *Decimal) Compare(other *Decimal) int {
+	return d.ToFraction().Compare(other.ToFraction())
+}
+
+// Equals compares values, so 1.5 equals 1.50
+func (d *Decimal) Equals(other *Decimal) bool {
+	return d.Compare(other) == 0
+}
+
+func (d *Decimal) GreaterThan(other *Decimal) bool {
+	return d.Compare(other) > 0
+}
+
+func (d *Decimal) GreaterThanOrEqualTo(other *Decimal) bool {
+	return d.Compare(other) >= 0
+}
+
+func (d *Decimal) LessThan(other *Decimal) bool {
+	return d.Compare(other) < 0
+}
+
+func (d *Decimal) LessThanOrEqualTo(other *Decimal) bool {
+	return d.Compare(other) <= 0
+}
+
+// #endregion
+
+// #region LaTeXer
+
+func (d *Decimal) LaTeX() string {
+	return d.String()
+}
+
+// #endregion
+
+// #region Operable
+
+// Add panics with ErrOverflow if the result does not fit in an int; see TryAdd.
+func (d *Decimal) Add(others ...*Decimal) *Decimal {
+	return mustDecimal(d.TryAdd(others...))
+}
+
+// Divide panics with ErrDivisionByZero, ErrNonTerminating or ErrOverflow; see TryDivide.
+func (d *Decimal) Divide(others ...*Decimal) *Decimal {
+	return mustDecimal(d.TryDivide(others...))
+}
+
+// Multiply panics with ErrOverflow if the result does not fit in an int; see TryMultiply.
+func (d *Decimal) Multiply(others ...*Decimal) *Decimal {
+	return mustDecimal(d.TryMultiply(others...))
+}
+
+// Subtract panics with ErrOverflow if the result does not fit in an int; see TrySubtract.
+func (d *Decimal) Subtract(others ...*Decimal) *Decimal {
+	return mustDecimal(d.TrySubtract(others...))
+}
+
+// TryAdd keeps the larger scale, e.g. 1.5 + 2.25 = 3.75
+func (d *Decimal) TryAdd(others ...*Decimal) (*Decimal, error) {
+	temp := d
+	for _, other := range others {
+		scale := Max(temp.scale, other.scale)
+		a, err := temp.rescale(scale)
+		if err != nil {
+			return nil, err
+		}
+		b, err := other.rescale(scale)
+		if err != nil {
+			return nil, err
+		}
+
+		sum, ok := addInts(a, b)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		temp = &Decimal{unscaled: sum, scale: scale}
+	}
+	return temp, nil
+}
+
+// TryDivide gives the exact quotient with as few places as it needs, e.g. 7.5 ÷ 0.25 = 30 and 1 ÷ 8 = 0.125;
+// a quotient that repeats, such as 1 ÷ 3, is ErrNonTerminating, so round a Fraction with ToDecimal instead
+func (d *Decimal) TryDivide(others ...*Decimal) (*Decimal, error) {
+	temp := d
+	for _, other := range others {
+		if other.unscaled == 0 {
+			return nil, ErrDivisionByZero
+		}
+
+		ten := big.NewInt(10)
+		numerator := new(big.Int).Mul(big.NewInt(int64(temp.unscaled)), new(big.Int).Exp(ten, big.NewInt(int64(other.scale)), nil))
+		denominator := new(big.Int).Mul(big.NewInt(int64(other.unscaled)), new(big.Int).Exp(ten, big.NewInt(int64(temp.scale)), nil))
+		quotient := new(big.Rat).SetFrac(numerator, denominator)
+
+		// the quotient terminates when its denominator is 2^a × 5^b, and then needs max(a, b) places
+		rest := new(big.Int).Set(quotient.Denom())
+		places := 0
+		for rest.Cmp(big.NewInt(1)) != 0 {
+			switch {
+			case new(big.Int).Mod(rest, ten).Sign() == 0:
+				rest.Quo(rest, ten)
+			case rest.Bit(0) == 0:
+				rest.Rsh(rest, 1)
+			case new(big.Int).Mod(rest, big.NewInt(5)).Sign() == 0:
+				rest.Quo(rest, big.NewInt(5))
+			default:
+				return nil, ErrNonTerminating
+			}
+			places++
+		}
+
+		unscaled := new(big.Int).Mul(quotient.Num(), new(big.Int).Exp(ten, big.NewInt(int64(places)), nil))
+		result, err := decimalFromBig(unscaled.Quo(unscaled, quotient.Denom()), places)
+		if err != nil {
+			return nil, err
+		}
+		temp = result
+	}
+	return temp, nil
+}
+
+// TryMultiply adds the scales, e.g. 1.5 × 0.25 = 0.375
+func (d *Decimal) TryMultiply(others ...*Decimal) (*Decimal, error) {
+	temp := d
+	for _, other := range others {
+		product, ok := multiplyInts(temp.unscaled, other.unscaled)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		result, err := NewDecimalE(product, temp.scale+other.scale)
+		if err != nil {
+			return nil, err
+		}
+		temp = result
+	}
+	return temp, nil
+}
+
+func (d *Decimal) TrySubtract(others ...*Decimal) (*Decimal, error) {
+	temp := d
+	for _, other := range others {
+		if other.unscaled == math.MinInt {
+			return nil, ErrOverflow
+		}
+		result, err := temp.TryAdd(&Decimal{unscaled: -other.unscaled, scale: other.scale})
+		if err != nil {
+			return nil, err
+		}
+		temp = result
+	}
+	return temp, nil
+}
+
+// #endregion
+
+// #region Stringer
+
+// String writes every digit of the scale, e.g. 12.50 or -0.05
+func (d *Decimal) String() string {
+	digits := strconv.Itoa(Abs(d.unscaled))
+	if d.unscaled == math.MinInt {
+		digits = strings.TrimPrefix(strconv.Itoa(d.unscaled), "-")
+	}
+
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+
+	if d.unscaled < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// #endregion
+
+// #region Public Methods
+
+// ToFraction returns the exact value, e.g. 12.50 is 25/2
+func (d *Decimal) ToFraction() *Fraction {
+	denominator, _ := powInt(10, d.scale)
+	return NewFraction(d.unscaled, denominator).simplifiedCopy()
+}
+
+// Round rounds to places decimal places, padding with zeros when there are fewer.
+// It panics with ErrOverflow; see TryRound.
+func (d *Decimal) Round(places int, mode RoundingMode) *Decimal {
+	return mustDecimal(d.TryRound(places, mode))
+}
+
+// TryRound rounds to places decimal places; a negative places rounds to tens, hundreds and so on
+func (d *Decimal) TryRound(places int, mode RoundingMode) (*Decimal, error) {
+	if places >= d.scale {
+		unscaled, err := d.rescale(places)
+		if err != nil {
+			return nil, err
+		}
+		return &Decimal{unscaled: unscaled, scale: places}, nil
+	}
+
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.scale-places)), nil)
+	rounded := roundQuotient(big.NewInt(int64(d.unscaled)), divisor, mode)
+
+	return decimalFromBig(rounded, places)
+}
+
+// RoundToSignificantFigures keeps figures significant digits, e.g. 1234.5 to 2 figures is 1200
+// and 0.0012345 to 3 figures is 0.00123. Zero is returned unchanged.
+func (d *Decimal) RoundToSignificantFigures(figures int, mode RoundingMode) (*Decimal, error) {
+	if figures < 1 {
+		return nil, fmt.Errorf("basicmath: significant figures must be at least 1, got %d", figures)
+	}
+	if d.unscaled == 0 {
+		return d, nil
+	}
+
+	rounded, err := d.TryRound(figures-1-d.exponent(), mode)
+	if err != nil {
+		return nil, err
+	}
+
+	// rounding up can carry into a new digit, e.g. 9.96 to 2 figures is 10.0, which has one digit too many
+	if rounded.exponent() != d.exponent() {
+		return rounded.TryRound(figures-1-rounded.exponent(), mode)
+	}
+	return rounded, nil
+}
+
+// ToScientific writes the decimal as m × 10^e with 1 ≤ |m| < 10 rounded to figures significant figures
+func (d *Decimal) ToScientific(figures int, mode RoundingMode) (*ScientificNotation, error) {
+	rounded, err := d.RoundToSignificantFigures(figures, mode)
+	if err != nil {
+		return nil, err
+	}
+	if rounded.unscaled == 0 {
+		return &ScientificNotation{Coefficient: NewDecimal(0, figures-1)}, nil
+	}
+
+	// the coefficient has the same digits with the point after the first one
+	exponent := rounded.exponent()
+	unscaled := rounded.unscaled
+	if shift := figures - 1 - rounded.scale - exponent; shift >= 0 {
+		factor, ok := powInt(10, shift)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		unscaled = mustInt(multiplyInts(unscaled, factor))
+	} else {
+		factor, _ := powInt(10, -shift)
+		unscaled /= factor
+	}
+	coefficient, err := NewDecimalE(unscaled, figures-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScientificNotation{Coefficient: coefficient, Exponent: exponent}, nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+func mustDecimal(d *Decimal, err error) *Decimal {
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// the unscaled value written with scale digits after the point; scale must not be smaller than d.scale
+func (d *Decimal) rescale(scale int) (int, error) {
+	factor, ok := powInt(10, scale-d.scale)
+	if !ok {
+		return 0, ErrOverflow
+	}
+	value, ok := multiplyInts(d.unscaled, factor)
+	if !ok {
+		return 0, ErrOverflow
+	}
+	if _, ok := powInt(10, scale); !ok {
+		return 0, ErrOverflow
+	}
+	return value, nil
+}
+
+// the power of ten of the leading digit, e.g. 2 for 123.4 and -3 for 0.0012
+func (d *Decimal) exponent() int {
+	return len(strconv.Itoa(Abs(d.unscaled))) - 1 - d.scale
+}
+
+// builds a decimal from a rounded unscaled value; a negative scale is multiplied out
+func decimalFromBig(unscaled *big.Int, scale int) (*Decimal, error) {
+	if !unscaled.IsInt64() || !fitsInInt(unscaled.Int64()) {
+		return nil, ErrOverflow
+	}
+	return NewDecimalE(int(unscaled.Int64()), scale)
+}
+
+// rounds n/d to an integer; d must be positive
+func roundQuotient(n, d *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	away := big.NewInt(int64(n.Sign()))
+	twice := new(big.Int).Abs(r)
+	twice.Lsh(twice, 1)
+
+	switch mode {
+	case RoundCeiling:
+		if n.Sign() > 0 {
+			return q.Add(q, away)
+		}
+	case RoundHalfUp:
+		if twice.Cmp(d) >= 0 {
+			return q.Add(q, away)
+		}
+	case RoundHalfEven:
+		if twice.Cmp(d) > 0 || (twice.Cmp(d) == 0 && q.Bit(0) == 1) {
+			return q.Add(q, away)
+		}
+	}
+
+	return q
+}
+
+// #endregion
@@ -0,0 +1,237 @@
+package basicmath
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantUnscaled int
+		wantScale    int
+		wantString   string
+	}{
+		{"ParseDecimal_Money", "12.50", 1250, 2, "12.50"},
+		{"ParseDecimal_Negative", "-0.05", -5, 2, "-0.05"},
+		{"ParseDecimal_NoWhole", ".5", 5, 1, "0.5"},
+		{"ParseDecimal_PlusSign", "+5", 5, 0, "5"},
+		{"ParseDecimal_PlusSignScientific", "+2.5e-1", 25, 2, "0.25"},
+		{"ParseDecimal_Integer", "42", 42, 0, "42"},
+		{"ParseDecimal_Scientific", "1.2e3", 1200, 0, "1200"},
+		{"ParseDecimal_NegativeExponent", "6.02E-5", 602, 7, "0.0000602"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDecimal() error = %v", err)
+			}
+			if got.Unscaled() != tt.wantUnscaled || got.Scale() != tt.wantScale {
+				t.Errorf("ParseDecimal() = %d×10^-%d, want %d×10^-%d", got.Unscaled(), got.Scale(), tt.wantUnscaled, tt.wantScale)
+			}
+			if got.String() != tt.wantString {
+				t.Errorf("String() = %v, want %v", got, tt.wantString)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "abc", "1e", "-", "1.2.3", "+", "+-5", "++5"} {
+		if _, err := ParseDecimal(input); err == nil {
+			t.Errorf("ParseDecimal(%q) should fail", input)
+		}
+	}
+}
+
+func TestDecimal_Round(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		places int
+		mode   RoundingMode
+		want   string
+	}{
+		{"Decimal_Round_HalfUp", "2.345", 2, RoundHalfUp, "2.35"},
+		{"Decimal_Round_HalfUpNegative", "-2.5", 0, RoundHalfUp, "-3"},
+		{"Decimal_Round_HalfEvenDown", "2.345", 2, RoundHalfEven, "2.34"},
+		{"Decimal_Round_HalfEvenUp", "2.355", 2, RoundHalfEven, "2.36"},
+		{"Decimal_Round_HalfEvenNotTie", "2.3451", 2, RoundHalfEven, "2.35"},
+		{"Decimal_Round_Truncate", "2.999", 1, RoundTruncate, "2.9"},
+		{"Decimal_Round_TruncateNegative", "-2.999", 1, RoundTruncate, "-2.9"},
+		{"Decimal_Round_Ceiling", "2.01", 1, RoundCeiling, "2.1"},
+		{"Decimal_Round_CeilingNegative", "-2.09", 1, RoundCeiling, "-2.0"},
+		{"Decimal_Round_Pad", "3.5", 2, RoundHalfUp, "3.50"},
+		{"Decimal_Round_Tens", "1234.5", -1, RoundHalfUp, "1230"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := ParseDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDecimal() error = %v", err)
+			}
+			if got := d.Round(tt.places, tt.mode); got.String() != tt.want {
+				t.Errorf("Round() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction_ToDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		fraction *Fraction
+		places   int
+		mode     RoundingMode
+		want     string
+	}{
+		{"Fraction_ToDecimal_TwoThirds", NewFraction(2, 3), 2, RoundHalfUp, "0.67"},
+		{"Fraction_ToDecimal_TwoThirdsTruncate", NewFraction(2, 3), 2, RoundTruncate, "0.66"},
+		{"Fraction_ToDecimal_NegativeCeiling", NewFraction(-2, 3), 3, RoundCeiling, "-0.666"},
+		{"Fraction_ToDecimal_Exact", NewFraction(1, 8), 4, RoundHalfEven, "0.1250"},
+		{"Fraction_ToDecimal_HalfEven", NewFraction(5, 2), 0, RoundHalfEven, "2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fraction.ToDecimal(tt.places, tt.mode)
+			if err != nil {
+				t.Fatalf("ToDecimal() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ToDecimal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewFraction(1, 3).ToDecimal(30, RoundHalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("ToDecimal() error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestDecimal_ToFraction(t *testing.T) {
+	if got := NewDecimal(1250, 2).ToFraction(); !got.Equals(NewFraction(25, 2)) {
+		t.Errorf("ToFraction() = %v, want 25/2", got)
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a, b := NewDecimal(15, 1), NewDecimal(225, 2)
+
+	if got := a.Add(b); got.String() != "3.75" {
+		t.Errorf("Add() = %v, want 3.75", got)
+	}
+	if got := a.Subtract(b); got.String() != "-0.75" {
+		t.Errorf("Subtract() = %v, want -0.75", got)
+	}
+	if got := a.Multiply(b); got.String() != "3.375" {
+		t.Errorf("Multiply() = %v, want 3.375", got)
+	}
+	if !NewDecimal(15, 1).Equals(NewDecimal(150, 2)) {
+		t.Errorf("Equals() should compare values, not scales")
+	}
+}
+
+func TestDecimal_TryDivide(t *testing.T) {
+	tests := []struct {
+		name     string
+		dividend *Decimal
+		divisor  *Decimal
+		want     string
+	}{
+		{"Decimal_TryDivide_Whole", NewDecimal(75, 1), NewDecimal(25, 2), "30"},
+		{"Decimal_TryDivide_Eighth", NewDecimal(1, 0), NewDecimal(8, 0), "0.125"},
+		{"Decimal_TryDivide_Negative", NewDecimal(-3, 0), NewDecimal(4, 1), "-7.5"},
+		{"Decimal_TryDivide_Money", NewDecimal(1250, 2), NewDecimal(4, 0), "3.125"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.dividend.TryDivide(tt.divisor)
+			if err != nil {
+				t.Fatalf("TryDivide() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("%v ÷ %v = %v, want %v", tt.dividend, tt.divisor, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewDecimal(1, 0).TryDivide(NewDecimal(3, 0)); !errors.Is(err, ErrNonTerminating) {
+		t.Errorf("1 ÷ 3 error = %v, want %v", err, ErrNonTerminating)
+	}
+	if _, err := NewDecimal(1, 0).TryDivide(NewDecimal(0, 2)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("1 ÷ 0 error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestDecimal_RoundToSignificantFigures(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		figures int
+		want    string
+	}{
+		{"Decimal_RoundToSignificantFigures_Large", "1234.5", 2, "1200"},
+		{"Decimal_RoundToSignificantFigures_Small", "0.0012345", 3, "0.00123"},
+		{"Decimal_RoundToSignificantFigures_Pad", "2.5", 4, "2.500"},
+		{"Decimal_RoundToSignificantFigures_Carry", "9.96", 2, "10"},
+		{"Decimal_RoundToSignificantFigures_Negative", "-0.05", 2, "-0.050"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := ParseDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDecimal() error = %v", err)
+			}
+			got, err := d.RoundToSignificantFigures(tt.figures, RoundHalfUp)
+			if err != nil {
+				t.Fatalf("RoundToSignificantFigures() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("RoundToSignificantFigures() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_ToScientific(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		figures   int
+		want      string
+		wantLaTeX string
+	}{
+		{"Decimal_ToScientific_Large", "1234.5", 3, "1.23 × 10^3", `1.23 \times 10^{3}`},
+		{"Decimal_ToScientific_Small", "0.000602", 3, "6.02 × 10^-4", `6.02 \times 10^{-4}`},
+		{"Decimal_ToScientific_Pad", "1200", 3, "1.20 × 10^3", `1.20 \times 10^{3}`},
+		{"Decimal_ToScientific_Carry", "99.97", 3, "1.00 × 10^2", `1.00 \times 10^{2}`},
+		{"Decimal_ToScientific_Negative", "-45", 1, "-5 × 10^1", `-5 \times 10^{1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := ParseDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDecimal() error = %v", err)
+			}
+			got, err := d.ToScientific(tt.figures, RoundHalfUp)
+			if err != nil {
+				t.Fatalf("ToScientific() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ToScientific() = %v, want %v", got, tt.want)
+			}
+			if got.LaTeX() != tt.wantLaTeX {
+				t.Errorf("LaTeX() = %v, want %v", got.LaTeX(), tt.wantLaTeX)
+			}
+
+			back, err := got.ToDecimal()
+			if err != nil {
+				t.Fatalf("ToDecimal() error = %v", err)
+			}
+			rounded, _ := d.RoundToSignificantFigures(tt.figures, RoundHalfUp)
+			if !back.Equals(rounded) {
+				t.Errorf("ToDecimal() = %v, want %v", back, rounded)
+			}
+		})
+	}
+}
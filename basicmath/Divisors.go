@@ -0,0 +1,186 @@
+package basicmath
+
+import (
+	"math/big"
+	"sort"
+)
+
+// #region Public Methods
+
+// Divisors returns the positive divisors of |n| in ascending order; 0 has none
+func Divisors(n int) []int {
+	return Factorize(n).Divisors()
+}
+
+// DivisorCount returns τ(n), the number of positive divisors of |n|
+func DivisorCount(n int) int {
+	return Factorize(n).DivisorCount()
+}
+
+// DivisorSum returns σ(n), the sum of the positive divisors of |n|; it panics with ErrOverflow if the sum does not fit in an int
+func DivisorSum(n int) int {
+	return Factorize(n).DivisorSum()
+}
+
+// EulerTotient returns φ(n), how many integers in [1, n] are coprime to n; it is 0 for n <= 0
+func EulerTotient(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return Factorize(n).Totient()
+}
+
+// Mobius returns μ(n): 0 when n has a squared prime factor, otherwise (-1)^k for k distinct primes.
+// It is 0 for n <= 0.
+func Mobius(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return Factorize(n).Mobius()
+}
+
+// IsPerfect reports whether n > 0 equals the sum of its proper divisors, e.g. 28 = 1 + 2 + 4 + 7 + 14
+func IsPerfect(n int) bool {
+	return n > 0 && compareAliquotSum(n) == 0
+}
+
+// IsAbundant reports whether the proper divisors of n > 0 sum to more than n
+func IsAbundant(n int) bool {
+	return n > 0 && compareAliquotSum(n) > 0
+}
+
+// IsDeficient reports whether the proper divisors of n > 0 sum to less than n
+func IsDeficient(n int) bool {
+	return n > 0 && compareAliquotSum(n) < 0
+}
+
+// IsSquareFree reports whether no prime divides n more than once; 0 is not square-free
+func IsSquareFree(n int) bool {
+	return n != 0 && Factorize(n).IsSquareFree()
+}
+
+// Divisors returns the positive divisors of the factored value in ascending order
+func (pf *PrimeFactorization) Divisors() []int {
+	if pf.value == 0 {
+		return []int{}
+	}
+
+	divisors := []int{1}
+	for _, factor := range pf.factors {
+		count := len(divisors)
+		power := 1
+		for i := 0; i < factor.Exponent; i++ {
+			power *= factor.Prime
+			for _, divisor := range divisors[:count] {
+				divisors = append(divisors, divisor*power)
+			}
+		}
+	}
+
+	sort.Ints(divisors)
+
+	return divisors
+}
+
+// DivisorCount returns τ, the product of (exponent + 1) over every prime
+func (pf *PrimeFactorization) DivisorCount() int {
+	if pf.value == 0 {
+		return 0
+	}
+
+	count := 1
+	for _, factor := range pf.factors {
+		count *= factor.Exponent + 1
+	}
+	return count
+}
+
+// DivisorSum returns σ, the product of 1 + p + ... + p^e over every prime power p^e
+func (pf *PrimeFactorization) DivisorSum() int {
+	if pf.value == 0 {
+		return 0
+	}
+
+	sum := 1
+	for _, factor := range pf.factors {
+		term, power := 1, 1
+		for i := 0; i < factor.Exponent; i++ {
+			power *= factor.Prime
+			term = mustInt(addInts(term, power))
+		}
+		sum = mustInt(multiplyInts(sum, term))
+	}
+	return sum
+}
+
+// Totient returns φ, the product of p^(e-1) * (p - 1) over every prime power p^e
+func (pf *PrimeFactorization) Totient() int {
+	if pf.value == 0 {
+		return 0
+	}
+
+	totient := 1
+	for _, factor := range pf.factors {
+		totient *= factor.Prime - 1
+		for i := 1; i < factor.Exponent; i++ {
+			totient *= factor.Prime
+		}
+	}
+	return totient
+}
+
+// Mobius returns μ, which is 0 unless the value is square-free
+func (pf *PrimeFactorization) Mobius() int {
+	if pf.value == 0 || !pf.IsSquareFree() {
+		return 0
+	}
+	if len(pf.factors)%2 == 0 {
+		return 1
+	}
+	return -1
+}
+
+// IsSquareFree reports whether every prime appears exactly once
+func (pf *PrimeFactorization) IsSquareFree() bool {
+	if pf.value == 0 {
+		return false
+	}
+
+	for _, factor := range pf.factors {
+		if factor.Exponent > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// #endregion
+
+// #region Private Methods
+
+// compares the sum of the divisors of n > 0 excluding n itself with n, i.e. σ(n) with 2n;
+// the sums are taken in math/big since σ(n) may not fit in an int even when n does
+func compareAliquotSum(n int) int {
+	sigma := big.NewInt(1)
+	for _, factor := range Factorize(n).Factors() {
+		prime := big.NewInt(int64(factor.Prime))
+		term, power := big.NewInt(1), big.NewInt(1)
+		for i := 0; i < factor.Exponent; i++ {
+			power.Mul(power, prime)
+			term.Add(term, power)
+		}
+		sigma.Mul(sigma, term)
+	}
+
+	twice := new(big.Int).Lsh(big.NewInt(int64(n)), 1)
+	return sigma.Cmp(twice)
+}
+
+func mustInt(value int, ok bool) int {
+	if !ok {
+		panic(ErrOverflow)
+	}
+	return value
+}
+
+// #endregion
@@ -0,0 +1,130 @@
+package basicmath
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDivisors(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "Divisors_Zero", n: 0, want: []int{}},
+		{name: "Divisors_One", n: 1, want: []int{1}},
+		{name: "Divisors_Prime", n: 13, want: []int{1, 13}},
+		{name: "Divisors_Composite", n: 60, want: []int{1, 2, 3, 4, 5, 6, 10, 12, 15, 20, 30, 60}},
+		{name: "Divisors_Square", n: 36, want: []int{1, 2, 3, 4, 6, 9, 12, 18, 36}},
+		{name: "Divisors_Negative", n: -12, want: []int{1, 2, 3, 4, 6, 12}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Divisors(tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Divisors(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArithmeticFunctions(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		tau     int
+		sigma   int
+		totient int
+		mobius  int
+	}{
+		{name: "ArithmeticFunctions_Zero", n: 0, tau: 0, sigma: 0, totient: 0, mobius: 0},
+		{name: "ArithmeticFunctions_One", n: 1, tau: 1, sigma: 1, totient: 1, mobius: 1},
+		{name: "ArithmeticFunctions_Prime", n: 7, tau: 2, sigma: 8, totient: 6, mobius: -1},
+		{name: "ArithmeticFunctions_TwoPrimes", n: 15, tau: 4, sigma: 24, totient: 8, mobius: 1},
+		{name: "ArithmeticFunctions_Squared", n: 12, tau: 6, sigma: 28, totient: 4, mobius: 0},
+		{name: "ArithmeticFunctions_ThreePrimes", n: 30, tau: 8, sigma: 72, totient: 8, mobius: -1},
+		{name: "ArithmeticFunctions_PrimePower", n: 1024, tau: 11, sigma: 2047, totient: 512, mobius: 0},
+		{name: "ArithmeticFunctions_Large", n: 600851475143, tau: 16, sigma: 610544148480, totient: 591194251200, mobius: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DivisorCount(tt.n); got != tt.tau {
+				t.Errorf("DivisorCount(%d) = %d, want %d", tt.n, got, tt.tau)
+			}
+			if got := DivisorSum(tt.n); got != tt.sigma {
+				t.Errorf("DivisorSum(%d) = %d, want %d", tt.n, got, tt.sigma)
+			}
+			if got := EulerTotient(tt.n); got != tt.totient {
+				t.Errorf("EulerTotient(%d) = %d, want %d", tt.n, got, tt.totient)
+			}
+			if got := Mobius(tt.n); got != tt.mobius {
+				t.Errorf("Mobius(%d) = %d, want %d", tt.n, got, tt.mobius)
+			}
+		})
+	}
+}
+
+func TestDivisorClassifiers(t *testing.T) {
+	tests := []struct {
+		name       string
+		n          int
+		perfect    bool
+		abundant   bool
+		deficient  bool
+		squareFree bool
+	}{
+		{name: "Classifiers_Zero", n: 0},
+		{name: "Classifiers_One", n: 1, deficient: true, squareFree: true},
+		{name: "Classifiers_Perfect", n: 28, perfect: true},
+		{name: "Classifiers_LargePerfect", n: 8128, perfect: true},
+		{name: "Classifiers_Abundant", n: 12, abundant: true},
+		{name: "Classifiers_OddAbundant", n: 945, abundant: true},
+		{name: "Classifiers_Deficient", n: 35, deficient: true, squareFree: true},
+		{name: "Classifiers_Negative", n: -6, squareFree: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPerfect(tt.n); got != tt.perfect {
+				t.Errorf("IsPerfect(%d) = %v", tt.n, got)
+			}
+			if got := IsAbundant(tt.n); got != tt.abundant {
+				t.Errorf("IsAbundant(%d) = %v", tt.n, got)
+			}
+			if got := IsDeficient(tt.n); got != tt.deficient {
+				t.Errorf("IsDeficient(%d) = %v", tt.n, got)
+			}
+			if got := IsSquareFree(tt.n); got != tt.squareFree {
+				t.Errorf("IsSquareFree(%d) = %v", tt.n, got)
+			}
+		})
+	}
+}
+
+func TestClassifyByDivisorSum_LargeValues(t *testing.T) {
+	// 3 · 2^61: σ(n) = 4 · (2^62 - 1) does not fit in an int
+	n := 6917529027641081856
+	if !IsAbundant(n) || IsPerfect(n) || IsDeficient(n) {
+		t.Errorf("%d should be abundant only", n)
+	}
+
+	// 2^62: σ(n) = 2^63 - 1 is just less than 2n
+	n = 1 << 62
+	if !IsDeficient(n) || IsPerfect(n) || IsAbundant(n) {
+		t.Errorf("%d should be deficient only", n)
+	}
+
+	// the largest perfect number that fits in an int, 2^30 · (2^31 - 1)
+	n = 2305843008139952128
+	if !IsPerfect(n) || IsAbundant(n) || IsDeficient(n) {
+		t.Errorf("%d should be perfect only", n)
+	}
+}
+
+func TestDivisorSum_Overflow(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrOverflow {
+			t.Errorf("DivisorSum() panic = %v, want %v", r, ErrOverflow)
+		}
+	}()
+
+	DivisorSum(6917529027641081856)
+}
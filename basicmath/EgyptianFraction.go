@@ -0,0 +1,169 @@
+package basicmath
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// #region Public Methods
+
+// EgyptianFraction writes a positive fraction as a sum of distinct unit fractions using the greedy
+// Fibonacci–Sylvester algorithm, e.g. 4/13 = 1/4 + 1/18 + 1/468. A value of 1 or more first takes
+// 1 + 1/2 + 1/3 + ... for as long as each term fits, e.g. 5/2 = 1 + 1/2 + 1/3 + 1/4 + 1/5 + 1/6 + 1/20.
+// Greedy denominators can grow very quickly, so ErrOverflow is returned when one does not fit in an int.
+func (f *Fraction) EgyptianFraction() ([]*Fraction, error) {
+	if f.n <= 0 {
+		return nil, fmt.Errorf("basicmath: Egyptian fractions need a positive value, got %s", f)
+	}
+
+	remaining := big.NewRat(int64(f.n), int64(f.d))
+	terms := []*Fraction{}
+
+	// the greedy step would repeat 1/1, so whole parts come from the harmonic series instead,
+	// which leaves a remainder below the last unit fraction used
+	for k := 1; remaining.Cmp(big.NewRat(1, int64(k))) >= 0; k++ {
+		if k > maxHarmonicTerms {
+			return nil, fmt.Errorf("basicmath: %s is too large to write as distinct unit fractions", f)
+		}
+		terms = append(terms, NewFraction(1, k))
+		remaining.Sub(remaining, big.NewRat(1, int64(k)))
+	}
+
+	for remaining.Sign() > 0 {
+		// the largest unit fraction not exceeding the remainder is 1/ceil(d/n)
+		numerator, denominator := remaining.Num(), remaining.Denom()
+		unit := new(big.Int).Add(denominator, new(big.Int).Sub(numerator, big.NewInt(1)))
+		unit.Quo(unit, numerator)
+
+		if !unit.IsInt64() || !fitsInInt(unit.Int64()) {
+			return nil, ErrOverflow
+		}
+
+		terms = append(terms, NewFraction(1, int(unit.Int64())))
+		remaining.Sub(remaining, new(big.Rat).SetFrac(big.NewInt(1), unit))
+	}
+
+	return terms, nil
+}
+
+// ShortestEgyptianFraction finds a decomposition into the fewest distinct unit fractions with every
+// denominator at most maxDenominator. Among the shortest decompositions it returns the one whose
+// largest denominator is smallest, e.g. 4/13 = 1/4 + 1/26 + 1/52 rather than the greedy 1/4 + 1/18 + 1/468.
+// ErrNoSolution is returned when no decomposition fits within the bound.
+func (f *Fraction) ShortestEgyptianFraction(maxDenominator int) ([]*Fraction, error) {
+	if f.n <= 0 {
+		return nil, fmt.Errorf("basicmath: Egyptian fractions need a positive value, got %s", f)
+	}
+
+	temp := f.simplifiedCopy()
+
+	// every denominator is at least ceil(d/n), so there are only so many unit fractions to choose from
+	first := (temp.d + temp.n - 1) / temp.n
+	if first > maxDenominator || !unitFractionsReach(temp, first, maxDenominator) {
+		return nil, ErrNoSolution
+	}
+
+	for length := 1; length <= maxDenominator-first+1; length++ {
+		search := &egyptianSearch{bound: maxDenominator, current: make([]int, 0, length)}
+		if err := search.find(temp.n, temp.d, length, first); err != nil {
+			return nil, err
+		}
+
+		if search.best != nil {
+			terms := make([]*Fraction, len(search.best))
+			for i, denominator := range search.best {
+				terms[i] = NewFraction(1, denominator)
+			}
+			return terms, nil
+		}
+	}
+
+	return nil, ErrNoSolution
+}
+
+// EgyptianFractionLaTeX joins unit fractions into a sum, e.g. \dfrac{1}{2}+\dfrac{1}{3}
+func EgyptianFractionLaTeX(terms []*Fraction) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term.LaTeX()
+	}
+	return strings.Join(parts, "+")
+}
+
+// #endregion
+
+// #region Private Methods
+
+// bounds the harmonic series used for values of 1 or more; 1 + 1/2 + ... + 1/1000 is about 7.49
+const maxHarmonicTerms = 1000
+
+// depth-first search over increasing denominators for a fixed number of terms
+type egyptianSearch struct {
+	bound   int
+	current []int
+	best    []int
+}
+
+func (s *egyptianSearch) find(n, d, terms, minDenominator int) error {
+	if terms == 1 {
+		if n == 1 && d >= minDenominator && d <= s.bound {
+			s.best = append(append([]int{}, s.current...), d)
+			// later solutions must have a smaller largest denominator
+			s.bound = d - 1
+		}
+		return nil
+	}
+
+	// 1/x <= n/d gives x >= ceil(d/n); n/d <= terms/x gives x <= floor(terms*d/n)
+	low := Max(minDenominator, (d+n-1)/n)
+	limit, ok := multiplyInts(terms, d)
+	if !ok {
+		return ErrOverflow
+	}
+	high := Min(s.bound, limit/n)
+
+	for x := low; x <= high && x <= s.bound; x++ {
+		// n/d - 1/x = (nx - d) / dx
+		nx, ok := multiplyInts(n, x)
+		if !ok {
+			return ErrOverflow
+		}
+		dx, ok := multiplyInts(d, x)
+		if !ok {
+			return ErrOverflow
+		}
+
+		remainderN, remainderD := nx-d, dx
+		if remainderN == 0 {
+			// 1/x uses up the whole value but more terms are still needed
+			continue
+		}
+		g := GCF(remainderN, remainderD)
+		remainderN, remainderD = remainderN/g, remainderD/g
+
+		s.current = append(s.current, x)
+		err := s.find(remainderN, remainderD, terms-1, x+1)
+		s.current = s.current[:len(s.current)-1]
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reports whether 1/first + ... + 1/last is at least f
+func unitFractionsReach(f *Fraction, first int, last int) bool {
+	target := big.NewRat(int64(f.n), int64(f.d))
+	sum := new(big.Rat)
+	for i := first; i <= last; i++ {
+		sum.Add(sum, big.NewRat(1, int64(i)))
+		if sum.Cmp(target) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// #endregion
@@ -0,0 +1,94 @@
+package basicmath
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFraction_EgyptianFraction(t *testing.T) {
+	tests := []struct {
+		name string
+		f    *Fraction
+		want []int
+	}{
+		{name: "EgyptianFraction_Unit", f: NewFraction(1, 7), want: []int{7}},
+		{name: "EgyptianFraction_TwoTerms", f: NewFraction(5, 6), want: []int{2, 3}},
+		{name: "EgyptianFraction_Greedy", f: NewFraction(4, 13), want: []int{4, 18, 468}},
+		{name: "EgyptianFraction_Unsimplified", f: NewFraction(6, 8), want: []int{2, 4}},
+		{name: "EgyptianFraction_Improper", f: NewFraction(3, 2), want: []int{1, 2}},
+		{name: "EgyptianFraction_One", f: NewInteger(1), want: []int{1}},
+		{name: "EgyptianFraction_FiveHalves", f: NewFraction(5, 2), want: []int{1, 2, 3, 4, 5, 6, 20}},
+		{name: "EgyptianFraction_Three", f: NewInteger(3), want: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 15, 230, 57960}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f.EgyptianFraction()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if denominators := unitDenominators(got); !reflect.DeepEqual(denominators, tt.want) {
+				t.Errorf("(%s).EgyptianFraction() = %v, want %v", tt.f, denominators, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewFraction(-1, 2).EgyptianFraction(); err == nil {
+		t.Error("EgyptianFraction() of a negative value should fail")
+	}
+	if _, err := NewFraction(5, 121).EgyptianFraction(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("(5/121).EgyptianFraction() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := NewInteger(8).EgyptianFraction(); err == nil {
+		t.Error("EgyptianFraction() of 8 should fail rather than use thousands of terms")
+	}
+}
+
+func TestFraction_ShortestEgyptianFraction(t *testing.T) {
+	tests := []struct {
+		name           string
+		f              *Fraction
+		maxDenominator int
+		want           []int
+		wantErr        error
+	}{
+		{name: "ShortestEgyptianFraction_Unit", f: NewFraction(1, 3), maxDenominator: 10, want: []int{3}},
+		{name: "ShortestEgyptianFraction_SmallerDenominators", f: NewFraction(4, 13), maxDenominator: 500, want: []int{4, 26, 52}},
+		{name: "ShortestEgyptianFraction_Greedy5_121", f: NewFraction(5, 121), maxDenominator: 2000, want: []int{33, 121, 363}},
+		{name: "ShortestEgyptianFraction_NeedsSplit", f: NewFraction(2, 3), maxDenominator: 10, want: []int{2, 6}},
+		{name: "ShortestEgyptianFraction_Whole", f: NewInteger(1), maxDenominator: 6, want: []int{1}},
+		{name: "ShortestEgyptianFraction_TooSmallBound", f: NewFraction(4, 13), maxDenominator: 20, wantErr: ErrNoSolution},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f.ShortestEgyptianFraction(tt.maxDenominator)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ShortestEgyptianFraction() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if denominators := unitDenominators(got); !reflect.DeepEqual(denominators, tt.want) {
+				t.Errorf("(%s).ShortestEgyptianFraction(%d) = %v, want %v", tt.f, tt.maxDenominator, denominators, tt.want)
+			}
+			if sum := NewInteger(0).Add(got...); !sum.Equals(tt.f) {
+				t.Errorf("terms sum to %s, want %s", sum, tt.f)
+			}
+		})
+	}
+}
+
+func TestEgyptianFractionLaTeX(t *testing.T) {
+	terms := []*Fraction{NewFraction(1, 2), NewFraction(1, 3)}
+	if got := EgyptianFractionLaTeX(terms); got != `\dfrac{1}{2}+\dfrac{1}{3}` {
+		t.Errorf("EgyptianFractionLaTeX() = %q", got)
+	}
+}
+
+func unitDenominators(terms []*Fraction) []int {
+	denominators := make([]int, len(terms))
+	for i, term := range terms {
+		denominators[i] = term.Denominator()
+	}
+	return denominators
+}
@@ -0,0 +1,43 @@
+package basicmath
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrZeroDenominator is returned when a fraction would be built with a denominator of zero
+	ErrZeroDenominator = errors.New("basicmath: zero denominator")
+	// ErrDivisionByZero is returned when dividing by a zero value
+	ErrDivisionByZero = errors.New("basicmath: division by zero")
+	// ErrInvalidBase is returned when a number base is outside 2 through 36
+	ErrInvalidBase = errors.New("basicmath: base must be between 2 and 36")
+	// ErrInvalidRootIndex is returned when a root index is less than 2
+	ErrInvalidRootIndex = errors.New("basicmath: root index must be at least 2")
+	// ErrInvalidModulus is returned when a modulus is not positive
+	ErrInvalidModulus = errors.New("basicmath: modulus must be positive")
+	// ErrNegativeRadicand is returned when taking an even root of a negative number
+	ErrNegativeRadicand = errors.New("basicmath: even root of a negative number")
+	// ErrNoInverse is returned when a value has no inverse modulo m
+	ErrNoInverse = errors.New("basicmath: no modular inverse")
+	// ErrNoSolution is returned when a congruence or equation has no integer solution
+	ErrNoSolution = errors.New("basicmath: no integer solution")
+	// ErrNonTerminating is returned when an exact decimal result would repeat forever, e.g. 1 ÷ 3
+	ErrNonTerminating = errors.New("basicmath: decimal does not terminate")
+	// ErrNotFinite is returned when converting NaN or an infinity
+	ErrNotFinite = errors.New("basicmath: value is not finite")
+	// ErrUnlikeRadicals is returned when adding radicals with different radicands or indexes
+	ErrUnlikeRadicals = errors.New("basicmath: radicals are not like terms")
+	// ErrOverflow is returned when an int result cannot be represented; use BigFraction for larger values
+	ErrOverflow = errors.New("basicmath: integer overflow")
+)
+
+// SyntaxError reports where text could not be parsed; Position is the 1-based character column
+type SyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("basicmath: %s at position %d", e.Message, e.Position)
+}
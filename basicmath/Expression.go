@@ -0,0 +1,360 @@
+package basicmath
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Expression is a parsed arithmetic expression over exact fractions; see ParseExpression
+type Expression struct {
+	root *expressionNode
+}
+
+type expressionNodeKind int
+
+const (
+	numberNode expressionNodeKind = iota
+	groupNode
+	negateNode
+	binaryNode
+)
+
+// expressionNode is one node of the syntax tree. Nodes are never changed once built, so a
+// reduction step builds a new tree that shares every untouched subtree with the old one.
+type expressionNode struct {
+	kind expressionNodeKind
+	// value and text are set on numbers; text is how a typed number was written, e.g. "2.5" or "1/2",
+	// and is empty for computed values
+	value *Fraction
+	text  string
+	// operator is one of + - * / ^ on binary nodes
+	operator rune
+	// left is the operand of groups and negations
+	left  *expressionNode
+	right *expressionNode
+}
+
+// the PEMDAS levels below parentheses, in the order they are reduced
+var expressionPrecedence = [][]rune{{'^'}, {'*', '/'}, {'+', '-'}}
+
+// #region LaTeXer
+
+// LaTeX renders the expression as written, e.g. 3 + 4 \cdot \left(2 - \dfrac{1}{2}\right)^{2}
+func (e *Expression) LaTeX() string {
+	return e.root.LaTeX()
+}
+
+// #endregion
+
+// #region Stringer
+
+func (e *Expression) String() string {
+	return e.root.String()
+}
+
+// #endregion
+
+// #region Public Methods
+
+// EvaluateExpression parses and evaluates an arithmetic expression exactly; see ParseExpression
+func EvaluateExpression(s string) (*Fraction, error) {
+	expression, err := ParseExpression(s)
+	if err != nil {
+		return nil, err
+	}
+	return expression.Evaluate()
+}
+
+// EvaluateExpressionWithSteps parses and evaluates an arithmetic expression, also returning each PEMDAS step
+func EvaluateExpressionWithSteps(s string) (*Fraction, *StepLog, error) {
+	expression, err := ParseExpression(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	return expression.EvaluateWithSteps()
+}
+
+// Evaluate returns the exact value. Exponents must evaluate to integers; ErrDivisionByZero and
+// ErrOverflow are returned like the Fraction Try methods.
+func (e *Expression) Evaluate() (*Fraction, error) {
+	return e.evaluate(nil)
+}
+
+// EvaluateWithSteps evaluates like Evaluate and also returns the work: the expression as written,
+// then the whole expression again after each single operation, in PEMDAS order. The innermost
+// parentheses are worked first, then exponents, then multiplication and division from left to
+// right, then addition and subtraction from left to right.
+func (e *Expression) EvaluateWithSteps() (*Fraction, *StepLog, error) {
+	steps := &StepLog{}
+	value, err := e.evaluate(steps)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, steps, nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+func (e *Expression) evaluate(steps *StepLog) (*Fraction, error) {
+	steps.Add("", e.root.LaTeX())
+
+	current := e.root.unwrap()
+	for current.kind != numberNode {
+		next, description, err := current.reduce(false)
+		if err != nil {
+			return nil, err
+		}
+		current = next.unwrap()
+		steps.Add(description, "= "+current.LaTeX())
+	}
+
+	return current.value.simplifiedCopy(), nil
+}
+
+func newBinaryNode(operator rune, left, right *expressionNode) *expressionNode {
+	return &expressionNode{kind: binaryNode, operator: operator, left: left, right: right}
+}
+
+func newComputedNode(value *Fraction) *expressionNode {
+	return &expressionNode{kind: numberNode, value: value.simplifiedCopy()}
+}
+
+func negateNumber(n *expressionNode) *expressionNode {
+	text := ""
+	if n.text != "" {
+		if strings.HasPrefix(n.text, "-") {
+			text = n.text[1:]
+		} else {
+			text = "-" + n.text
+		}
+	}
+	return &expressionNode{kind: numberNode, value: NewFraction(-n.value.n, n.value.d), text: text}
+}
+
+// drops parentheses around a single number and folds the negation of a number into the number
+func (n *expressionNode) unwrap() *expressionNode {
+	switch n.kind {
+	case groupNode:
+		inner := n.left.unwrap()
+		if inner.kind == numberNode {
+			return inner
+		}
+		if inner != n.left {
+			return &expressionNode{kind: groupNode, left: inner}
+		}
+	case negateNode:
+		operand := n.left.unwrap()
+		if operand.kind == numberNode {
+			return negateNumber(&expressionNode{kind: numberNode, value: operand.value})
+		}
+		if operand != n.left {
+			return &expressionNode{kind: negateNode, left: operand}
+		}
+	case binaryNode:
+		left, right := n.left.unwrap(), n.right.unwrap()
+		if left != n.left || right != n.right {
+			return newBinaryNode(n.operator, left, right)
+		}
+	}
+	return n
+}
+
+// performs the next operation in PEMDAS order, returning the new tree and a description of the step
+func (n *expressionNode) reduce(insideParentheses bool) (*expressionNode, string, error) {
+	if group := n.firstGroup(); group != nil {
+		inner, description, err := group.left.reduce(true)
+		if err != nil {
+			return nil, "", err
+		}
+		return n.replace(group, &expressionNode{kind: groupNode, left: inner}), description, nil
+	}
+
+	for _, operators := range expressionPrecedence {
+		target := n.firstReady(operators)
+		if target == nil {
+			continue
+		}
+
+		value, description, err := target.apply()
+		if err != nil {
+			return nil, "", err
+		}
+		if insideParentheses {
+			description += " inside the parentheses"
+		}
+		return n.replace(target, newComputedNode(value)), description, nil
+	}
+
+	// unwrap leaves no other kind of node behind
+	return nil, "", fmt.Errorf("basicmath: cannot reduce %s", n)
+}
+
+// the leftmost parenthesized group, not looking inside groups
+func (n *expressionNode) firstGroup() *expressionNode {
+	switch n.kind {
+	case groupNode:
+		return n
+	case negateNode:
+		return n.left.firstGroup()
+	case binaryNode:
+		if group := n.left.firstGroup(); group != nil {
+			return group
+		}
+		return n.right.firstGroup()
+	}
+	return nil
+}
+
+// the leftmost operation using one of operators whose operands are both numbers
+func (n *expressionNode) firstReady(operators []rune) *expressionNode {
+	switch n.kind {
+	case negateNode:
+		return n.left.firstReady(operators)
+	case binaryNode:
+		if n.left.kind == numberNode && n.right.kind == numberNode && strings.ContainsRune(string(operators), n.operator) {
+			return n
+		}
+		if ready := n.left.firstReady(operators); ready != nil {
+			return ready
+		}
+		return n.right.firstReady(operators)
+	}
+	return nil
+}
+
+// returns a copy of the tree with target swapped for replacement
+func (n *expressionNode) replace(target, replacement *expressionNode) *expressionNode {
+	if n == target {
+		return replacement
+	}
+
+	switch n.kind {
+	case groupNode, negateNode:
+		return &expressionNode{kind: n.kind, left: n.left.replace(target, replacement)}
+	case binaryNode:
+		return newBinaryNode(n.operator, n.left.replace(target, replacement), n.right.replace(target, replacement))
+	}
+	return n
+}
+
+// computes a binary operation on two numbers
+func (n *expressionNode) apply() (*Fraction, string, error) {
+	a, b := n.left.value, n.right.value
+
+	switch n.operator {
+	case '+':
+		value, err := a.TryAdd(b)
+		return value, "add", err
+	case '-':
+		value, err := a.TrySubtract(b)
+		return value, "subtract", err
+	case '*':
+		value, err := a.TryMultiply(b)
+		return value, "multiply", err
+	case '/':
+		value, err := a.TryDivide(b)
+		return value, "divide", err
+	case '^':
+		if !b.IsInteger() {
+			return nil, "", fmt.Errorf("basicmath: exponent %s is not an integer", b)
+		}
+		value, err := a.TryPow(b.n / b.d)
+		return value, "evaluate the exponent", err
+	}
+
+	return nil, "", fmt.Errorf("basicmath: unknown operator %q", n.operator)
+}
+
+func (n *expressionNode) LaTeX() string {
+	switch n.kind {
+	case numberNode:
+		if numerator, denominator, found := strings.Cut(n.text, "/"); found {
+			if strings.HasPrefix(numerator, "-") {
+				return fmt.Sprintf(`-\dfrac{%s}{%s}`, numerator[1:], denominator)
+			}
+			return fmt.Sprintf(`\dfrac{%s}{%s}`, numerator, denominator)
+		}
+		if n.text != "" {
+			return n.text
+		}
+		return n.value.LaTeX()
+	case groupNode:
+		return fmt.Sprintf(`\left(%s\right)`, n.left.LaTeX())
+	case negateNode:
+		return "-" + n.left.operandLaTeX()
+	}
+
+	if n.operator == '^' {
+		base := n.left.LaTeX()
+		if n.left.needsParenthesesAsBase() {
+			base = fmt.Sprintf(`\left(%s\right)`, base)
+		}
+		return fmt.Sprintf("%s^{%s}", base, n.right.LaTeX())
+	}
+
+	return fmt.Sprintf("%s %s %s", n.left.LaTeX(), expressionOperatorLaTeX[n.operator], n.right.operandLaTeX())
+}
+
+func (n *expressionNode) String() string {
+	switch n.kind {
+	case numberNode:
+		if n.text != "" {
+			return n.text
+		}
+		return n.value.String()
+	case groupNode:
+		return fmt.Sprintf("(%s)", n.left)
+	case negateNode:
+		return "-" + n.left.operandString('-')
+	}
+
+	if n.operator == '^' {
+		base := n.left.String()
+		if n.left.needsParenthesesAsBase() {
+			base = fmt.Sprintf("(%s)", base)
+		}
+		return fmt.Sprintf("%s^%s", base, n.right.operandString('^'))
+	}
+
+	return fmt.Sprintf("%s %c %s", n.left, n.operator, n.right.operandString(n.operator))
+}
+
+var expressionOperatorLaTeX = map[rune]string{
+	'+': "+",
+	'-': "-",
+	'*': `\cdot`,
+	'/': `\div`,
+}
+
+// a negative number or a negation after an operator is wrapped so the signs stay readable, e.g. 3 - \left(-2\right)
+func (n *expressionNode) needsParenthesesAsOperand() bool {
+	return n.kind == negateNode || (n.kind == numberNode && n.value.n < 0)
+}
+
+// only whole numbers and groups can be raised to a power without parentheses
+func (n *expressionNode) needsParenthesesAsBase() bool {
+	if n.kind == numberNode {
+		return n.value.n < 0 || !n.value.IsInteger() || strings.Contains(n.text, ".")
+	}
+	return n.kind != groupNode
+}
+
+func (n *expressionNode) operandLaTeX() string {
+	if n.needsParenthesesAsOperand() {
+		return fmt.Sprintf(`\left(%s\right)`, n.LaTeX())
+	}
+	return n.LaTeX()
+}
+
+// plain text also wraps a fraction after *, / or ^ so it does not read as more division, e.g. 2 * (3/4)
+func (n *expressionNode) operandString(operator rune) string {
+	isFraction := n.kind == numberNode && strings.Contains(n.String(), "/")
+	if n.needsParenthesesAsOperand() || (isFraction && operator != '+' && operator != '-') {
+		return fmt.Sprintf("(%s)", n)
+	}
+	return n.String()
+}
+
+// #endregion
@@ -0,0 +1,283 @@
+package basicmath
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type expressionTokenKind int
+
+const (
+	numberToken expressionTokenKind = iota
+	operatorToken
+	leftParenthesisToken
+	rightParenthesisToken
+	endToken
+)
+
+type expressionToken struct {
+	kind     expressionTokenKind
+	text     string
+	operator rune
+	position int
+}
+
+// #region Public Methods
+
+// ParseExpression reads an arithmetic expression such as "3 + 4 * (2 - 1/2)^2".
+// Numbers may be integers or decimals; the operators are + - * / ^ (also −, ×, ·, ÷) with parentheses,
+// unary minus and implicit multiplication such as 2(3 + 1). An integer over an integer written with a slash,
+// like 1/2, is kept as a fraction, while 1 ÷ 2 is a division step. Errors are *SyntaxError values giving the column of the problem.
+func ParseExpression(s string) (*Expression, error) {
+	tokens, err := tokenizeExpression(s)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &expressionParser{tokens: tokens}
+	root, err := parser.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := parser.peek(); token.kind != endToken {
+		return nil, parser.unexpected(token)
+	}
+
+	return &Expression{root: root}, nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+func tokenizeExpression(s string) ([]expressionToken, error) {
+	runes := []rune(s)
+	tokens := []expressionToken{}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		position := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			text := string(runes[start:i])
+			if strings.Count(text, ".") > 1 || text == "." {
+				return nil, &SyntaxError{Position: position, Message: fmt.Sprintf("invalid number %q", text)}
+			}
+			tokens = append(tokens, expressionToken{kind: numberToken, text: text, position: position})
+		case r == '(':
+			tokens = append(tokens, expressionToken{kind: leftParenthesisToken, text: "(", position: position})
+			i++
+		case r == ')':
+			tokens = append(tokens, expressionToken{kind: rightParenthesisToken, text: ")", position: position})
+			i++
+		default:
+			operator, ok := expressionOperators[r]
+			if !ok {
+				return nil, &SyntaxError{Position: position, Message: fmt.Sprintf("unexpected character %q", r)}
+			}
+			tokens = append(tokens, expressionToken{kind: operatorToken, text: string(r), operator: operator, position: position})
+			i++
+		}
+	}
+
+	return append(tokens, expressionToken{kind: endToken, position: len(runes) + 1}), nil
+}
+
+// maps every accepted operator symbol to its ASCII form
+var expressionOperators = map[rune]rune{
+	'+': '+',
+	'-': '-',
+	'−': '-',
+	'*': '*',
+	'×': '*',
+	'·': '*',
+	'⋅': '*',
+	'/': '/',
+	'÷': '/',
+	'^': '^',
+}
+
+// recursive descent parser; from lowest to highest precedence:
+//
+//	sum     = product { ("+" | "-") product }
+//	product = unary { ("*" | "/") unary | implicit product }
+//	unary   = "-" unary | "+" unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | "(" sum ")"
+type expressionParser struct {
+	tokens  []expressionToken
+	current int
+}
+
+func (p *expressionParser) peek() expressionToken {
+	return p.tokens[p.current]
+}
+
+func (p *expressionParser) next() expressionToken {
+	token := p.tokens[p.current]
+	if token.kind != endToken {
+		p.current++
+	}
+	return token
+}
+
+func (p *expressionParser) unexpected(token expressionToken) error {
+	if token.kind == endToken {
+		return &SyntaxError{Position: token.position, Message: "unexpected end of expression"}
+	}
+	return &SyntaxError{Position: token.position, Message: fmt.Sprintf("unexpected %q", token.text)}
+}
+
+func (p *expressionParser) isOperator(operators ...rune) bool {
+	token := p.peek()
+	if token.kind != operatorToken {
+		return false
+	}
+	for _, operator := range operators {
+		if token.operator == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *expressionParser) parseSum() (*expressionNode, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator('+', '-') {
+		operator := p.next().operator
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = newBinaryNode(operator, left, right)
+	}
+
+	return left, nil
+}
+
+func (p *expressionParser) parseProduct() (*expressionNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		operator, symbol := '*', ""
+		switch {
+		case p.isOperator('*', '/'):
+			token := p.next()
+			operator, symbol = token.operator, token.text
+		case p.implicitMultiplication():
+		default:
+			return left, nil
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		if symbol == "/" && isIntegerLiteral(left) && isIntegerLiteral(right) && right.value.n > 0 {
+			// a fraction written with a slash such as 3/4 stays a single number; 3 ÷ 4 is a division
+			left = &expressionNode{kind: numberNode, value: left.value.Divide(right.value).simplifiedCopy(), text: left.text + "/" + right.text}
+		} else {
+			left = newBinaryNode(operator, left, right)
+		}
+	}
+}
+
+// a parenthesis straight after an operand, as in 2(3 + 1) or (1 + 2)(3 + 4), or a number straight after a closing parenthesis
+func (p *expressionParser) implicitMultiplication() bool {
+	token := p.peek()
+	previous := p.tokens[p.current-1]
+	return token.kind == leftParenthesisToken || (token.kind == numberToken && previous.kind == rightParenthesisToken)
+}
+
+func (p *expressionParser) parseUnary() (*expressionNode, error) {
+	if !p.isOperator('+', '-') {
+		return p.parsePower()
+	}
+
+	operator := p.next().operator
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if operator == '+' {
+		return operand, nil
+	}
+
+	if operand.kind == numberNode {
+		// -3 is a negative number rather than the negation of 3
+		return negateNumber(operand), nil
+	}
+	return &expressionNode{kind: negateNode, left: operand}, nil
+}
+
+func (p *expressionParser) parsePower() (*expressionNode, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isOperator('^') {
+		return base, nil
+	}
+	p.next()
+
+	// exponents group to the right, so 2^3^2 is 2^(3^2), and may be negative as in 2^-1
+	exponent, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	return newBinaryNode('^', base, exponent), nil
+}
+
+func (p *expressionParser) parsePrimary() (*expressionNode, error) {
+	token := p.next()
+
+	switch token.kind {
+	case numberToken:
+		value, err := ParseRepeatingDecimal(token.text)
+		if err != nil {
+			return nil, &SyntaxError{Position: token.position, Message: fmt.Sprintf("invalid number %q", token.text)}
+		}
+		value.Simplify()
+		return &expressionNode{kind: numberNode, value: value, text: token.text}, nil
+	case leftParenthesisToken:
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != rightParenthesisToken {
+			if closing.kind == endToken {
+				return nil, &SyntaxError{Position: token.position, Message: "unmatched \"(\""}
+			}
+			return nil, p.unexpected(closing)
+		}
+		return &expressionNode{kind: groupNode, left: inner}, nil
+	}
+
+	return nil, p.unexpected(token)
+}
+
+// a number typed as a whole number, such as 3 or -3, rather than a decimal, a fraction or a computed value
+func isIntegerLiteral(n *expressionNode) bool {
+	return n.kind == numberNode && n.text != "" && !strings.ContainsAny(n.text, "./")
+}
+
+// #endregion
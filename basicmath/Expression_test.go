@@ -0,0 +1,181 @@
+package basicmath
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEvaluateExpression(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  *Fraction
+	}{
+		{"EvaluateExpression_Example", "3 + 4 * (2 - 1/2)^2", NewInteger(12)},
+		{"EvaluateExpression_LeftToRight", "8 / 4 * 2", NewInteger(4)},
+		{"EvaluateExpression_SubtractLeftToRight", "10 - 3 - 2", NewInteger(5)},
+		{"EvaluateExpression_UnaryMinusBeforePower", "-2^2", NewInteger(-4)},
+		{"EvaluateExpression_NegativeBase", "(-2)^2", NewInteger(4)},
+		{"EvaluateExpression_RightAssociativePower", "2^3^2", NewInteger(512)},
+		{"EvaluateExpression_NegativeExponent", "(2/3)^-2", NewFraction(9, 4)},
+		{"EvaluateExpression_DoubleNegative", "3 - -2", NewInteger(5)},
+		{"EvaluateExpression_ImplicitMultiplication", "2(3 + 1)(1/2)", NewInteger(4)},
+		{"EvaluateExpression_Decimals", "0.5 × 4 − 1.25", NewFraction(3, 4)},
+		{"EvaluateExpression_DivideByFraction", "6 ÷ (1/2)", NewInteger(12)},
+		{"EvaluateExpression_NestedParentheses", "((1 + 2) * (3 - 5))^2 / 4", NewInteger(9)},
+		{"EvaluateExpression_HugeExponentOne", "1^999999999999", NewInteger(1)},
+		{"EvaluateExpression_HugeExponentMinusOne", "(-1)^999999999999", NewInteger(-1)},
+		{"EvaluateExpression_HugeExponentZero", "0^999999999999", NewInteger(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EvaluateExpression(tt.input)
+			if err != nil {
+				t.Fatalf("EvaluateExpression() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("EvaluateExpression() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateExpression_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"EvaluateExpression_Errors_DivisionByZero", "1 / (2 - 2)", ErrDivisionByZero},
+		{"EvaluateExpression_Errors_ZeroDenominator", "1/0", ErrDivisionByZero},
+		{"EvaluateExpression_Errors_Overflow", "10^30", ErrOverflow},
+		{"EvaluateExpression_Errors_HugeExponentOverflow", "2^999999999999", ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := EvaluateExpression(tt.input); !errors.Is(err, tt.wantErr) {
+				t.Errorf("EvaluateExpression() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := EvaluateExpression("4^(1/2)"); err == nil {
+		t.Errorf("EvaluateExpression() with a fractional exponent should fail")
+	}
+}
+
+func TestParseExpression_SyntaxErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantPosition int
+		wantMessage  string
+	}{
+		{"ParseExpression_SyntaxErrors_Empty", "", 1, "unexpected end of expression"},
+		{"ParseExpression_SyntaxErrors_TrailingOperator", "3 +", 4, "unexpected end of expression"},
+		{"ParseExpression_SyntaxErrors_Unmatched", "2 * (1 + 2", 5, `unmatched "("`},
+		{"ParseExpression_SyntaxErrors_ExtraClosing", "1 + 2)", 6, `unexpected ")"`},
+		{"ParseExpression_SyntaxErrors_Character", "3 $ 4", 3, `unexpected character '$'`},
+		{"ParseExpression_SyntaxErrors_Number", "1.2.3 + 1", 1, `invalid number "1.2.3"`},
+		{"ParseExpression_SyntaxErrors_AdjacentNumbers", "2 3", 3, `unexpected "3"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseExpression(tt.input)
+
+			var syntaxError *SyntaxError
+			if !errors.As(err, &syntaxError) {
+				t.Fatalf("ParseExpression() error = %v, want a *SyntaxError", err)
+			}
+			if syntaxError.Position != tt.wantPosition || syntaxError.Message != tt.wantMessage {
+				t.Errorf("ParseExpression() error = %v, want %q at position %d", err, tt.wantMessage, tt.wantPosition)
+			}
+		})
+	}
+}
+
+func TestExpression_LaTeX(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"Expression_LaTeX_Example", "3 + 4 * (2 - 1/2)^2", `3 + 4 \cdot \left(2 - \dfrac{1}{2}\right)^{2}`},
+		{"Expression_LaTeX_Division", "7 ÷ 2", `7 \div 2`},
+		{"Expression_LaTeX_NegativeOperand", "3 - -1/2", `3 - \left(-\dfrac{1}{2}\right)`},
+		{"Expression_LaTeX_Negation", "-(1 + 2)", `-\left(1 + 2\right)`},
+		{"Expression_LaTeX_Decimal", "2.5^2", `\left(2.5\right)^{2}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := ParseExpression(tt.input)
+			if err != nil {
+				t.Fatalf("ParseExpression() error = %v", err)
+			}
+			if got := expression.LaTeX(); got != tt.want {
+				t.Errorf("LaTeX() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpression_String(t *testing.T) {
+	expression, err := ParseExpression("2(3+1) − 2^-1")
+	if err != nil {
+		t.Fatalf("ParseExpression() error = %v", err)
+	}
+
+	if got, want := expression.String(), "2 * (3 + 1) - 2^(-1)"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+}
+
+func TestExpression_EvaluateWithSteps(t *testing.T) {
+	expression, err := ParseExpression("3 + 4 * (2 - 1/2)^2")
+	if err != nil {
+		t.Fatalf("ParseExpression() error = %v", err)
+	}
+
+	got, steps, err := expression.EvaluateWithSteps()
+	if err != nil {
+		t.Fatalf("EvaluateWithSteps() error = %v", err)
+	}
+	if !got.Equals(NewInteger(12)) {
+		t.Errorf("EvaluateWithSteps() = %v, want 12", got)
+	}
+
+	want := []Step{
+		{"", `3 + 4 \cdot \left(2 - \dfrac{1}{2}\right)^{2}`},
+		{"subtract inside the parentheses", `= 3 + 4 \cdot \left(\dfrac{3}{2}\right)^{2}`},
+		{"evaluate the exponent", `= 3 + 4 \cdot \dfrac{9}{4}`},
+		{"multiply", `= 3 + 9`},
+		{"add", `= 12`},
+	}
+	gotSteps := steps.Steps()
+	if len(gotSteps) != len(want) {
+		t.Fatalf("EvaluateWithSteps() gave %d steps, want %d:\n%s", len(gotSteps), len(want), steps)
+	}
+	for i := range want {
+		if gotSteps[i] != want[i] {
+			t.Errorf("step %d = %+v, want %+v", i+1, gotSteps[i], want[i])
+		}
+	}
+}
+
+func TestExpression_EvaluateWithSteps_LeftToRight(t *testing.T) {
+	_, steps, err := EvaluateExpressionWithSteps("12 ÷ 3 * 2 - 1 + 2^2")
+	if err != nil {
+		t.Fatalf("EvaluateExpressionWithSteps() error = %v", err)
+	}
+
+	want := []string{"", "evaluate the exponent", "divide", "multiply", "subtract", "add"}
+	gotSteps := steps.Steps()
+	if len(gotSteps) != len(want) {
+		t.Fatalf("EvaluateExpressionWithSteps() gave %d steps, want %d:\n%s", len(gotSteps), len(want), steps)
+	}
+	for i, description := range want {
+		if gotSteps[i].Description != description {
+			t.Errorf("step %d = %q, want %q", i+1, gotSteps[i].Description, description)
+		}
+	}
+}
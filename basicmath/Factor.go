@@ -1,10 +1,11 @@
 package basicmath
 
+// FactorInt returns the prime factorization of a as a map of prime to exponent.
+// Negative values include -1, and 0 and 1 map to themselves.
 func FactorInt(a int) map[int]int {
 	factors := make(map[int]int)
 	if a < 0 {
 		factors[-1] = 1
-		a *= -1
 	}
 
 	if a == 0 {
@@ -17,20 +18,9 @@ func FactorInt(a int) map[int]int {
 		return factors
 	}
 
-	product := 1
-
-	for a%2 == 0 {
-		factors[2]++
-		product *= 2
-		a /= 2
+	for _, prime := range PrimeFactors(a) {
+		factors[prime]++
 	}
 
-	for i := 3; i <= a || product == a; i += 2 {
-		for a%i == 0 {
-			factors[i]++
-			product *= i
-			a /= i
-		}
-	}
 	return factors
-}
\ No newline at end of file
+}
@@ -0,0 +1,49 @@
+package basicmath
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFactorInt(t *testing.T) {
+	tests := []struct {
+		name string
+		a    int
+		want map[int]int
+	}{
+		{name: "Factor_FactorInt_Zero", a: 0, want: map[int]int{0: 1}},
+		{name: "Factor_FactorInt_One", a: 1, want: map[int]int{1: 1}},
+		{name: "Factor_FactorInt_Test01", a: 84, want: map[int]int{2: 2, 3: 1, 7: 1}},
+		{name: "Factor_FactorInt_Negative", a: -18, want: map[int]int{-1: 1, 2: 1, 3: 2}},
+		{name: "Factor_FactorInt_12Digit", a: 600851475143, want: map[int]int{71: 1, 839: 1, 1471: 1, 6857: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FactorInt(tt.a); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FactorInt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction_Factor(t *testing.T) {
+	tests := []struct {
+		name string
+		f    *Fraction
+		want string
+	}{
+		{name: "Fraction_Factor_Test01", f: NewFraction(12, 35), want: "(2 * 2 * 3)/(5 * 7)"},
+		{name: "Fraction_Factor_Test02", f: NewInteger(60), want: "2 * 2 * 3 * 5"},
+		{name: "Fraction_Factor_Test03", f: NewFraction(-9, 4), want: "(-1 * 3 * 3)/(2 * 2)"},
+		{name: "Fraction_Factor_Test04", f: NewFraction(1, 8), want: "(1)/(2 * 2 * 2)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				if got := tt.f.Factor(); got != tt.want {
+					t.Fatalf("Fraction.Factor() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -3,8 +3,7 @@ package basicmath
 import (
 	"fmt"
 	"math"
-	"strings"
-	"strconv"
+	"math/big"
 )
 
 // #region Constructor
@@ -14,13 +13,31 @@ type Fraction struct {
 	n, d int
 }
 
+// NewFraction panics with ErrZeroDenominator or ErrOverflow on invalid input; use NewFractionE to get the error instead.
 func NewFraction(numerator int, denominator int) *Fraction {
+	f, err := NewFractionE(numerator, denominator)
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
+
+// NewFractionE builds a fraction, returning ErrZeroDenominator or ErrOverflow instead of panicking
+func NewFractionE(numerator int, denominator int) (*Fraction, error) {
+	if denominator == 0 {
+		return nil, ErrZeroDenominator
+	}
+
 	if denominator < 0 {
+		if numerator == math.MinInt || denominator == math.MinInt {
+			return nil, ErrOverflow
+		}
 		numerator *= -1
 		denominator *= -1
 	}
 
-	return &Fraction{n: numerator, d: denominator}
+	return &Fraction{n: numerator, d: denominator}, nil
 }
 
 func NewInteger(value int) *Fraction {
@@ -46,34 +63,28 @@ func (f *Fraction) Denominator() int {
 // #region Comparable
 
 func (f *Fraction) Compare(other *Fraction) int {
-	if f.n*other.d < other.n*f.d {
-		return -1
-	} else if f.n*other.d > other.n*f.d {
-		return 1
-	}
-
-	return 0
+	return compareCrossProducts(f.n, f.d, other.n, other.d)
 }
 
 func (f *Fraction) Equals(other *Fraction) bool {
 	// Cross-multiply to compare without floating-point operations
-	return f.n*other.d == other.n*f.d
+	return f.Compare(other) == 0
 }
 
 func (f *Fraction) GreaterThan(other *Fraction) bool {
-	return f.n*other.d > other.n*f.d
+	return f.Compare(other) > 0
 }
 
 func (f *Fraction) GreaterThanOrEqualTo(other *Fraction) bool {
-	return f.n*other.d >= other.n*f.d
+	return f.Compare(other) >= 0
 }
 
 func (f *Fraction) LessThan(other *Fraction) bool {
-	return f.n*other.d < other.n*f.d
+	return f.Compare(other) < 0
 }
 
 func (f *Fraction) LessThanOrEqualTo(other *Fraction) bool {
-	return f.n*other.d <= other.n*f.d
+	return f.Compare(other) <= 0
 }
 
 // #endregion
@@ -81,38 +92,13 @@ func (f *Fraction) LessThanOrEqualTo(other *Fraction) bool {
 // #region Factorable
 
 func (f *Fraction) Factor() string {
-	factors_n := FactorInt(f.n)
-	factors_d := FactorInt(f.d)
-
-	var num strings.Builder
-	var denom strings.Builder
-
-	for factor, count := range factors_n {
-		for i := 0; i < count; i++ {
-			if num.Len() > 0 {
-				num.WriteString(" * ")
-			}
-			num.WriteString(fmt.Sprintf("%d", factor))
-		}
-	}
-
-	for factor, count := range factors_d {
-		for i := 0; i < count; i++ {
-			if denom.Len() > 0 {
-				denom.WriteString(" * ")
-			}
-			denom.WriteString(fmt.Sprintf("%d", factor))
-		}
-	}
+	numerator, denominator := f.PrimeFactorizations()
 
-	var result string
-	if len(factors_d) > 0 {
-		result = fmt.Sprintf("(%s)/(%s)", num.String(), denom.String())
-	} else {
-		result = num.String()
+	if f.d == 1 {
+		return numerator.String()
 	}
 
-	return result
+	return fmt.Sprintf("(%s)/(%s)", numerator, denominator)
 }
 
 // #endregion
@@ -135,46 +121,20 @@ func (f *Fraction) LaTeX() string {
 
 // #region Operable
 
+// Add panics with ErrOverflow if the result does not fit in an int; see TryAdd.
 func (f *Fraction) Add(others ...*Fraction) *Fraction {
-	f.Simplify()
-	temp := NewFraction(f.n, f.d)
-
-	for _, other := range others {
-		other.Simplify()
-
-		if temp.d == other.d {
-			temp.n = temp.n + other.n
-			temp.d = other.d
-		} else {
-			lcm := LCM(temp.d, other.d)
-			left := &Fraction{n: lcm / temp.d, d: lcm / temp.d}
-			right := &Fraction{n: lcm / other.d, d: lcm / other.d}
-
-			temp.n = temp.n*left.n + other.n*right.n
-			temp.d = temp.d * left.d
-		}
-
-		temp.Simplify()
-	}
-
-	return temp
+	return mustFraction(f.TryAdd(others...))
 }
 
+// Divide panics with ErrDivisionByZero or ErrOverflow; see TryDivide.
 func (f *Fraction) Divide(others ...*Fraction) *Fraction {
-	temp := NewFraction(f.n, f.d)
-
-	for _, other := range others {
-		f1 := NewFraction(other.d, other.n)
-		temp = temp.Multiply(f1)
-	}
-
-	return temp
+	return mustFraction(f.TryDivide(others...))
 }
 
+// DividedByFloat panics with ErrNotFinite for NaN or an infinity, or with ErrOverflow for a float such as 1e20
+// that has no int fraction; see TryDividedByFloat.
 func (f Fraction) DividedByFloat(others ...float64) *Fraction {
-	floats := floatsToFraction(others...)
-
-	return f.Divide(floats...)
+	return mustFraction(f.TryDividedByFloat(others...))
 }
 
 func (f Fraction) DividedBy(others ...*Fraction) *Fraction {
@@ -185,48 +145,186 @@ func (f Fraction) Minus(others ...*Fraction) *Fraction {
 	return f.Subtract(others...)
 }
 
+// Multiply panics with ErrOverflow if the result does not fit in an int; see TryMultiply.
 func (f *Fraction) Multiply(others ...*Fraction) *Fraction {
-	temp := NewFraction(f.n, f.d)
+	return mustFraction(f.TryMultiply(others...))
+}
+
+// MultiplyByFactor panics with ErrNotFinite or ErrOverflow like DividedByFloat; see TryMultiplyByFactor.
+func (f Fraction) MultiplyByFactor(others ...float64) *Fraction {
+	return mustFraction(f.TryMultiplyByFactor(others...))
+}
+
+func (f Fraction) Plus(others ...*Fraction) *Fraction {
+	return f.Add(others...)
+}
+
+// PlusFloat panics with ErrNotFinite or ErrOverflow like DividedByFloat; see TryPlusFloat.
+func (f *Fraction) PlusFloat(others ...float64) *Fraction {
+	return mustFraction(f.TryPlusFloat(others...))
+}
+
+// Subtract panics with ErrOverflow if the result does not fit in an int; see TrySubtract.
+func (f *Fraction) Subtract(others ...*Fraction) *Fraction {
+	return mustFraction(f.TrySubtract(others...))
+}
+
+func (f Fraction) Times(others ...*Fraction) *Fraction {
+	return f.Multiply(others...)
+}
+
+// TryAdd adds the fractions, returning ErrOverflow instead of wrapping around
+func (f *Fraction) TryAdd(others ...*Fraction) (*Fraction, error) {
+	temp, err := f.checkedCopy()
+	if err != nil {
+		return nil, err
+	}
 
 	for _, other := range others {
-		temp.n = temp.n * other.n
-		temp.d = temp.d * other.d
+		o, err := other.checkedCopy()
+		if err != nil {
+			return nil, err
+		}
+
+		lcm, ok := multiplyInts(temp.d/GCF(temp.d, o.d), o.d)
+		if !ok {
+			return nil, ErrOverflow
+		}
+
+		left, leftOk := multiplyInts(temp.n, lcm/temp.d)
+		right, rightOk := multiplyInts(o.n, lcm/o.d)
+		if !leftOk || !rightOk {
+			return nil, ErrOverflow
+		}
+
+		n, ok := addInts(left, right)
+		if !ok {
+			return nil, ErrOverflow
+		}
+
+		temp = &Fraction{n: n, d: lcm}
 		temp.Simplify()
 	}
 
-	return temp
+	return temp, nil
 }
 
-func (f Fraction) MultiplyByFactor(others ...float64) *Fraction {
-	floats := floatsToFraction(others...)
+// TryDivide divides the fractions, returning ErrDivisionByZero or ErrOverflow instead of building an invalid value
+func (f *Fraction) TryDivide(others ...*Fraction) (*Fraction, error) {
+	temp, err := f.checkedCopy()
+	if err != nil {
+		return nil, err
+	}
 
-	return f.Multiply(floats...)
-}
+	for _, other := range others {
+		if other.d == 0 {
+			return nil, ErrZeroDenominator
+		}
+		if other.n == 0 {
+			return nil, ErrDivisionByZero
+		}
 
-func (f Fraction) Plus(others ...*Fraction) *Fraction {
-	return f.Add(others...)
+		reciprocal, err := NewFractionE(other.d, other.n)
+		if err != nil {
+			return nil, err
+		}
+
+		temp, err = temp.TryMultiply(reciprocal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return temp, nil
 }
 
-func (f *Fraction) PlusFloat(others ...float64) *Fraction {
-	floats := floatsToFraction(others...)
+// TryDividedByFloat converts each float as FromFloatToFraction does, returning ErrNotFinite or ErrOverflow instead of panicking
+func (f Fraction) TryDividedByFloat(others ...float64) (*Fraction, error) {
+	floats, err := floatsToFraction(others...)
+	if err != nil {
+		return nil, err
+	}
 
-	return f.Add(floats...)
+	return f.TryDivide(floats...)
 }
 
-func (f *Fraction) Subtract(others ...*Fraction) *Fraction {
-	temp := NewFraction(f.n, f.d)
+// TryMultiply multiplies the fractions, returning ErrOverflow instead of wrapping around
+func (f *Fraction) TryMultiply(others ...*Fraction) (*Fraction, error) {
+	temp, err := f.checkedCopy()
+	if err != nil {
+		return nil, err
+	}
 
 	for _, other := range others {
-		f1 := other.Multiply(NewInteger(-1))
+		if other.d == 0 {
+			return nil, ErrZeroDenominator
+		}
 
-		temp = temp.Add(f1)
+		// cancel common factors first so intermediate products stay small
+		g1 := GCF(temp.n, other.d)
+		g2 := GCF(other.n, temp.d)
+		if g1 == 0 {
+			g1 = 1
+		}
+		if g2 == 0 {
+			g2 = 1
+		}
+
+		n, nOk := multiplyInts(temp.n/g1, other.n/g2)
+		d, dOk := multiplyInts(temp.d/g2, other.d/g1)
+		if !nOk || !dOk {
+			return nil, ErrOverflow
+		}
+
+		temp = &Fraction{n: n, d: d}
+		temp.Simplify()
 	}
 
-	return temp
+	return temp, nil
 }
 
-func (f Fraction) Times(others ...*Fraction) *Fraction {
-	return f.Multiply(others...)
+// TryMultiplyByFactor converts each float as FromFloatToFraction does, returning ErrNotFinite or ErrOverflow instead of panicking
+func (f Fraction) TryMultiplyByFactor(others ...float64) (*Fraction, error) {
+	floats, err := floatsToFraction(others...)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.TryMultiply(floats...)
+}
+
+// TryPlusFloat converts each float as FromFloatToFraction does, returning ErrNotFinite or ErrOverflow instead of panicking
+func (f *Fraction) TryPlusFloat(others ...float64) (*Fraction, error) {
+	floats, err := floatsToFraction(others...)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.TryAdd(floats...)
+}
+
+// TrySubtract subtracts the fractions, returning ErrOverflow instead of wrapping around
+func (f *Fraction) TrySubtract(others ...*Fraction) (*Fraction, error) {
+	temp, err := f.checkedCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, other := range others {
+		if other.d == 0 {
+			return nil, ErrZeroDenominator
+		}
+		if other.n == math.MinInt {
+			return nil, ErrOverflow
+		}
+
+		temp, err = temp.TryAdd(&Fraction{n: -other.n, d: other.d})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return temp, nil
 }
 
 // #endregion
@@ -278,8 +376,11 @@ func FactorsOf(fraction *Fraction) map[*Fraction]*Fraction {
 	return factors
 }
 
+// FromFloatToFraction returns the simplest fraction within DefaultFloatTolerance of value, e.g. 0.333333 becomes 1/3.
+// Use ApproximateFloat or ApproximateFloatWithTolerance to control the approximation.
+// It panics with ErrNotFinite for NaN or an infinity and with ErrOverflow when value does not fit in an int.
 func FromFloatToFraction(value float64) *Fraction {
-	return floatToFraction(value)
+	return mustFraction(floatToFraction(value))
 }
 
 func GetFractionGCF(fractions ...*Fraction) *Fraction {
@@ -331,35 +432,75 @@ func (f *Fraction) Min(fractions ...*Fraction) *Fraction {
 
 // #region Private Methods
 
-func floatsToFraction(others ...float64) []*Fraction {
-	floats := []*Fraction{}
+func mustFraction(f *Fraction, err error) *Fraction {
+	if err != nil {
+		panic(err)
+	}
 
-	for _, other := range others {
-		floats = append(floats, floatToFraction(other))
+	return f
+}
+
+// copies the fraction in lowest terms with a positive denominator, returning ErrZeroDenominator
+// for the zero value Fraction{} or any other fraction with a zero denominator
+func (f *Fraction) checkedCopy() (*Fraction, error) {
+	temp, err := NewFractionE(f.n, f.d)
+	if err != nil {
+		return nil, err
 	}
+	temp.Simplify()
 
-	return floats
+	return temp, nil
 }
 
-func floatToFraction(value float64) *Fraction {
-	s := strconv.FormatFloat(value, 'f', -1, 64)
-	parts := strings.Split(s, ".")
+func (f *Fraction) simplifiedCopy() *Fraction {
+	temp := &Fraction{n: f.n, d: f.d}
+	temp.Simplify()
 
-	if len(parts) > 0 && len(parts) <= 2{
-		nTemp, _ := strconv.Atoi(parts[0])
+	return temp
+}
 
-		if len(parts) == 2 {
-			dTemp, _ := strconv.Atoi(parts[1])
-			d := int(math.Pow10(len(parts[1])))
-			n := (nTemp * d) + dTemp
+// compares a/b with c/d by cross-multiplying, falling back to math/big when the products overflow
+func compareCrossProducts(a, b, c, d int) int {
+	left, leftOk := multiplyInts(a, d)
+	right, rightOk := multiplyInts(c, b)
 
-			return NewFraction(n, d)
-		} else {
-			return NewFraction(nTemp, 1)
+	if leftOk && rightOk {
+		if left < right {
+			return -1
+		} else if left > right {
+			return 1
 		}
+		return 0
+	}
+
+	bigLeft := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(d)))
+	bigRight := new(big.Int).Mul(big.NewInt(int64(c)), big.NewInt(int64(b)))
+
+	return bigLeft.Cmp(bigRight)
+}
+
+func floatsToFraction(others ...float64) ([]*Fraction, error) {
+	floats := []*Fraction{}
+
+	for _, other := range others {
+		float, err := floatToFraction(other)
+		if err != nil {
+			return nil, err
+		}
+		floats = append(floats, float)
+	}
+
+	return floats, nil
+}
+
+// converts using continued fractions; returns ErrNotFinite for NaN and infinities
+func floatToFraction(value float64) (*Fraction, error) {
+	tolerance := DefaultFloatTolerance
+	if abs := math.Abs(value); abs < 1e-3 {
+		tolerance *= abs
 	}
 
-	return nil
+	return ApproximateFloatWithTolerance(value, tolerance)
 }
 
 func getGCFofTwoFractions(a, b *Fraction) *Fraction {
@@ -0,0 +1,219 @@
+package basicmath
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// StructuredFraction marshals to JSON as an object such as {"n":3,"d":4}
+// instead of the "3/4" string used by Fraction.
+type StructuredFraction struct {
+	*Fraction
+}
+
+type fractionObject struct {
+	N int  `json:"n"`
+	D *int `json:"d,omitempty"`
+}
+
+// #region Public Methods
+
+// ParseFraction reads text such as "5", "-3/4", "−3/4", "3 / 4", "0.75", "0.(3)", "\dfrac{3}{4}" or "-\frac{3}{4}"
+func ParseFraction(s string) (*Fraction, error) {
+	text := strings.TrimSpace(strings.ReplaceAll(s, "−", "-"))
+
+	negative := false
+	if strings.HasPrefix(text, `-\`) {
+		negative = true
+		text = strings.TrimSpace(text[1:])
+	}
+
+	var f *Fraction
+	var err error
+
+	switch {
+	case strings.HasPrefix(text, `\`):
+		f, err = parseLaTeXFraction(text)
+	case strings.Contains(text, "."):
+		f, err = ParseRepeatingDecimal(text)
+	default:
+		f, err = parseSimpleFraction(strings.Join(strings.Fields(text), ""))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("basicmath: invalid fraction %q: %w", s, err)
+	}
+
+	f.Simplify()
+	if negative {
+		f.n = -f.n
+	}
+
+	return f, nil
+}
+
+// #endregion
+
+// #region Marshaling
+
+func (f Fraction) MarshalText() ([]byte, error) {
+	if f.d == 0 {
+		return nil, ErrZeroDenominator
+	}
+
+	return []byte(f.String()), nil
+}
+
+func (f *Fraction) UnmarshalText(text []byte) error {
+	parsed, err := ParseFraction(string(text))
+	if err != nil {
+		return err
+	}
+
+	*f = *parsed
+	return nil
+}
+
+// MarshalJSON writes the fraction as a string such as "3/4"
+func (f Fraction) MarshalJSON() ([]byte, error) {
+	text, err := f.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON accepts a string ("3/4"), an integer (5) or an object ({"n":3,"d":4}), storing the value
+// in lowest terms; null leaves the fraction unchanged
+func (f *Fraction) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case string(data) == "null":
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return f.UnmarshalText([]byte(text))
+	case len(data) > 0 && data[0] == '{':
+		var object fractionObject
+		if err := json.Unmarshal(data, &object); err != nil {
+			return err
+		}
+
+		denominator := 1
+		if object.D != nil {
+			denominator = *object.D
+		}
+
+		parsed, err := NewFractionE(object.N, denominator)
+		if err != nil {
+			return err
+		}
+		parsed.Simplify()
+
+		*f = *parsed
+		return nil
+	default:
+		var value int
+		if err := json.Unmarshal(data, &value); err != nil {
+			return fmt.Errorf("basicmath: invalid JSON fraction %s", data)
+		}
+
+		*f = *NewInteger(value)
+		return nil
+	}
+}
+
+func (s StructuredFraction) MarshalJSON() ([]byte, error) {
+	if s.Fraction == nil {
+		return []byte("null"), nil
+	}
+	if s.d == 0 {
+		return nil, ErrZeroDenominator
+	}
+
+	d := s.d
+	return json.Marshal(fractionObject{N: s.n, D: &d})
+}
+
+func (s *StructuredFraction) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		s.Fraction = nil
+		return nil
+	}
+
+	f := &Fraction{}
+	if err := f.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	s.Fraction = f
+	return nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+// parses \frac{n}{d}, \dfrac{n}{d} or \tfrac{n}{d}
+func parseLaTeXFraction(text string) (*Fraction, error) {
+	for _, command := range []string{`\dfrac`, `\tfrac`, `\frac`} {
+		if !strings.HasPrefix(text, command) {
+			continue
+		}
+
+		numerator, rest, err := readBraced(strings.TrimSpace(text[len(command):]))
+		if err != nil {
+			return nil, err
+		}
+
+		denominator, rest, err := readBraced(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(rest) != "" {
+			return nil, fmt.Errorf("unexpected text %q after %s", rest, command)
+		}
+
+		n, err := parseSimpleFraction(strings.TrimSpace(numerator))
+		if err != nil {
+			return nil, err
+		}
+
+		d, err := parseSimpleFraction(strings.TrimSpace(denominator))
+		if err != nil {
+			return nil, err
+		}
+
+		if d.n == 0 {
+			return nil, ErrZeroDenominator
+		}
+
+		return n.TryDivide(d)
+	}
+
+	return nil, fmt.Errorf("unsupported LaTeX command in %q", text)
+}
+
+// reads "{...}" from the start of text and returns the contents and what follows
+func readBraced(text string) (string, string, error) {
+	if !strings.HasPrefix(text, "{") {
+		return "", "", fmt.Errorf("expected '{' in %q", text)
+	}
+
+	end := strings.Index(text, "}")
+	if end < 0 {
+		return "", "", fmt.Errorf("missing '}' in %q", text)
+	}
+
+	return text[1:end], text[end+1:], nil
+}
+
+// #endregion
@@ -0,0 +1,146 @@
+package basicmath
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseFraction(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    *Fraction
+		wantErr error
+	}{
+		{name: "ParseFraction_Integer", s: "5", want: NewInteger(5)},
+		{name: "ParseFraction_Negative", s: "-3/4", want: NewFraction(-3, 4)},
+		{name: "ParseFraction_UnicodeMinus", s: "−3/4", want: NewFraction(-3, 4)},
+		{name: "ParseFraction_Spaces", s: " 3 / 4 ", want: NewFraction(3, 4)},
+		{name: "ParseFraction_Simplifies", s: "6/8", want: NewFraction(3, 4)},
+		{name: "ParseFraction_NegativeDenominator", s: "3/-4", want: NewFraction(-3, 4)},
+		{name: "ParseFraction_DFrac", s: `\dfrac{3}{4}`, want: NewFraction(3, 4)},
+		{name: "ParseFraction_NegativeDFrac", s: `-\dfrac{3}{4}`, want: NewFraction(-3, 4)},
+		{name: "ParseFraction_Frac", s: `\frac{-10}{4}`, want: NewFraction(-5, 2)},
+		{name: "ParseFraction_Decimal", s: "0.75", want: NewFraction(3, 4)},
+		{name: "ParseFraction_RepeatingDecimal", s: "0.(3)", want: NewFraction(1, 3)},
+		{name: "ParseFraction_ZeroDenominator", s: "3/0", wantErr: ErrZeroDenominator},
+		{name: "ParseFraction_LaTeXZeroDenominator", s: `\dfrac{3}{0}`, wantErr: ErrZeroDenominator},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFraction(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseFraction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFraction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, s := range []string{"", "abc", "3/", `\dfrac{3}`, `\sqrt{2}`, "1/2/3"} {
+		if _, err := ParseFraction(s); err == nil {
+			t.Errorf("ParseFraction(%q) expected an error", s)
+		}
+	}
+}
+
+func TestFraction_JSON(t *testing.T) {
+	type problem struct {
+		Answer  *Fraction          `json:"answer"`
+		Given   Fraction           `json:"given"`
+		Exact   StructuredFraction `json:"exact"`
+		Choices []*Fraction        `json:"choices"`
+	}
+
+	original := problem{
+		Answer:  NewFraction(-3, 4),
+		Given:   *NewInteger(5),
+		Exact:   StructuredFraction{NewFraction(2, 3)},
+		Choices: []*Fraction{NewFraction(1, 2), NewFraction(7, 3)},
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"answer":"-3/4","given":"5","exact":{"n":2,"d":3},"choices":["1/2","7/3"]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var decoded problem
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(decoded, original) {
+		t.Errorf("json.Unmarshal() = %+v, want %+v", decoded, original)
+	}
+}
+
+func TestFraction_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *Fraction
+		wantErr bool
+	}{
+		{name: "Fraction_UnmarshalJSON_String", data: `"\\dfrac{3}{4}"`, want: NewFraction(3, 4)},
+		{name: "Fraction_UnmarshalJSON_Number", data: `12`, want: NewInteger(12)},
+		{name: "Fraction_UnmarshalJSON_Object", data: `{"n": 6, "d": -8}`, want: NewFraction(-3, 4)},
+		{name: "Fraction_UnmarshalJSON_ObjectNoDenominator", data: `{"n": 6}`, want: NewInteger(6)},
+		{name: "Fraction_UnmarshalJSON_ZeroDenominator", data: `{"n": 6, "d": 0}`, wantErr: true},
+		{name: "Fraction_UnmarshalJSON_Float", data: `1.5`, wantErr: true},
+		{name: "Fraction_UnmarshalJSON_BadString", data: `"x/y"`, wantErr: true},
+		{name: "Fraction_UnmarshalJSON_StringSimplified", data: `"6/8"`, want: NewFraction(3, 4)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &Fraction{}
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Fraction.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fraction.UnmarshalJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction_UnmarshalJSON_Null(t *testing.T) {
+	got := NewFraction(3, 4)
+	if err := got.UnmarshalJSON([]byte(" null ")); err != nil {
+		t.Fatalf("Fraction.UnmarshalJSON(null) error = %v", err)
+	}
+	if !reflect.DeepEqual(got, NewFraction(3, 4)) {
+		t.Errorf("Fraction.UnmarshalJSON(null) = %v, want 3/4 unchanged", got)
+	}
+
+	var value struct {
+		F Fraction `json:"f"`
+	}
+	value.F = *NewFraction(1, 2)
+	if err := json.Unmarshal([]byte(`{"f": null}`), &value); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !value.F.Equals(NewFraction(1, 2)) {
+		t.Errorf("json.Unmarshal() with null = %v, want 1/2 unchanged", &value.F)
+	}
+}
+
+func TestFraction_MarshalText(t *testing.T) {
+	if _, err := (Fraction{}).MarshalText(); !errors.Is(err, ErrZeroDenominator) {
+		t.Errorf("Fraction.MarshalText() error = %v, want %v", err, ErrZeroDenominator)
+	}
+
+	text, err := NewFraction(-7, 2).MarshalText()
+	if err != nil || string(text) != "-7/2" {
+		t.Errorf("Fraction.MarshalText() = %s, %v, want -7/2", text, err)
+	}
+}
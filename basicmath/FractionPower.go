@@ -0,0 +1,170 @@
+package basicmath
+
+import "math"
+
+// #region Public Methods
+
+// Pow raises the fraction to an integer power; negative exponents give the reciprocal, e.g. (2/3)^-2 = 9/4.
+// It panics with ErrDivisionByZero for 0 to a negative power or ErrOverflow; see TryPow.
+func (f *Fraction) Pow(exponent int) *Fraction {
+	return mustFraction(f.TryPow(exponent))
+}
+
+// TryPow raises the fraction to an integer power using repeated squaring
+func (f *Fraction) TryPow(exponent int) (*Fraction, error) {
+	base := f.simplifiedCopy()
+
+	if exponent < 0 {
+		if base.n == 0 {
+			return nil, ErrDivisionByZero
+		}
+		if exponent == math.MinInt {
+			// -exponent does not fit; only 1 and -1 survive such a power
+			if base.d != 1 || Abs(base.n) != 1 {
+				return nil, ErrOverflow
+			}
+			return NewInteger(1), nil
+		}
+		reciprocal, err := NewFractionE(base.d, base.n)
+		if err != nil {
+			return nil, err
+		}
+		base = reciprocal
+		exponent = -exponent
+	}
+
+	n, nOk := powInt(base.n, exponent)
+	d, dOk := powInt(base.d, exponent)
+	if !nOk || !dOk {
+		return nil, ErrOverflow
+	}
+
+	return &Fraction{n: n, d: d}, nil
+}
+
+// PowFraction raises the fraction to a rational power, e.g. (8/27)^(2/3) = 4/9.
+// The result is exact: it is rational when the root is perfect (see Radical.ToFraction) and an
+// irrational radical otherwise, e.g. 2^(1/2) = √2. Even roots of negative values return ErrNegativeRadicand.
+func (f *Fraction) PowFraction(exponent *Fraction) (*Radical, error) {
+	e := exponent.simplifiedCopy()
+
+	if e.d != 1 && f.IsPerfectPower(e.d) {
+		// take the root first so the power stays small, e.g. (2^40)^(3/2) = (2^20)^3
+		root, err := f.Root(e.d)
+		if err != nil {
+			return nil, err
+		}
+		base, _ := root.ToFraction()
+
+		power, err := base.TryPow(e.n)
+		if err != nil {
+			return nil, err
+		}
+		return NewRationalRadical(power), nil
+	}
+
+	power, err := f.TryPow(e.n)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.d == 1 {
+		return NewRationalRadical(power), nil
+	}
+
+	return power.Root(e.d)
+}
+
+// Root returns the exact index-th root of the fraction, rationalizing the denominator,
+// e.g. the square root of 1/2 is √2/2
+func (f *Fraction) Root(index int) (*Radical, error) {
+	if index < 1 {
+		return nil, ErrInvalidRootIndex
+	}
+
+	temp := f.simplifiedCopy()
+	if index == 1 || temp.n == 0 {
+		return NewRationalRadical(temp), nil
+	}
+
+	if temp.n == math.MinInt {
+		return nil, ErrOverflow
+	}
+
+	// take the root of the numerator and denominator first, ⁿ√(a^n·b / (c^n·t)) = (a/c)·ⁿ√(b/t),
+	// then rationalize what is left of the denominator, ⁿ√(b/t) = ⁿ√(b·t^(n-1)) / t
+	outside, inside := splitPerfectPower(Abs(temp.n), index)
+	root, leftover := splitPerfectPower(temp.d, index)
+
+	scale, ok := powInt(leftover, index-1)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	radicand, ok := multiplyInts(inside, scale)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	if temp.n < 0 {
+		radicand = -radicand
+	}
+
+	return NewRadicalE(NewFraction(outside, mustInt(multiplyInts(root, leftover))), radicand, index)
+}
+
+// IsPerfectSquare reports whether the fraction is the square of a fraction, e.g. 9/16
+func (f *Fraction) IsPerfectSquare() bool {
+	return f.IsPerfectPower(2)
+}
+
+// IsPerfectPower reports whether the fraction is the exponent-th power of a fraction, e.g. -8/27 for 3
+func (f *Fraction) IsPerfectPower(exponent int) bool {
+	if exponent < 1 {
+		return false
+	}
+
+	temp := f.simplifiedCopy()
+	if temp.n < 0 && exponent%2 == 0 {
+		return false
+	}
+
+	return isPerfectPower(temp.n, exponent) && isPerfectPower(temp.d, exponent)
+}
+
+// IsPerfectSquare reports whether n is the square of an integer
+func IsPerfectSquare(n int) bool {
+	return n >= 0 && isPerfectPower(n, 2)
+}
+
+// IsPerfectPower reports whether n is the exponent-th power of an integer, e.g. 32 for 5 or -27 for 3
+func IsPerfectPower(n int, exponent int) bool {
+	if exponent < 1 || (n < 0 && exponent%2 == 0) {
+		return false
+	}
+	return isPerfectPower(n, exponent)
+}
+
+// #endregion
+
+// #region Private Methods
+
+// splits n > 0 into root^index · rest where rest has no index-th power factor, e.g. 24 is 2^3 · 3
+func splitPerfectPower(n int, index int) (root int, rest int) {
+	root, rest = 1, 1
+	for _, factor := range Factorize(n).Factors() {
+		root *= mustInt(powInt(factor.Prime, factor.Exponent/index))
+		rest *= mustInt(powInt(factor.Prime, factor.Exponent%index))
+	}
+	return root, rest
+}
+
+// reports whether every prime exponent of |n| is a multiple of exponent
+func isPerfectPower(n int, exponent int) bool {
+	for _, factor := range Factorize(n).Factors() {
+		if factor.Exponent%exponent != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// #endregion
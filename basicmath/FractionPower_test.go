@@ -0,0 +1,169 @@
+package basicmath
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFraction_Pow(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        *Fraction
+		exponent int
+		want     *Fraction
+	}{
+		{name: "Fraction_Pow_Positive", f: NewFraction(2, 3), exponent: 4, want: NewFraction(16, 81)},
+		{name: "Fraction_Pow_Zero", f: NewFraction(2, 3), exponent: 0, want: NewInteger(1)},
+		{name: "Fraction_Pow_ZeroBase", f: NewInteger(0), exponent: 0, want: NewInteger(1)},
+		{name: "Fraction_Pow_Negative", f: NewFraction(2, 3), exponent: -2, want: NewFraction(9, 4)},
+		{name: "Fraction_Pow_NegativeBase", f: NewFraction(-1, 2), exponent: -3, want: NewInteger(-8)},
+		{name: "Fraction_Pow_Unsimplified", f: NewFraction(4, 6), exponent: 2, want: NewFraction(4, 9)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.Pow(tt.exponent); !got.Equals(tt.want) {
+				t.Errorf("(%s)^%d = %s, want %s", tt.f, tt.exponent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction_TryPow_Errors(t *testing.T) {
+	if _, err := NewInteger(0).TryPow(-1); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("0^-1 error = %v, want %v", err, ErrDivisionByZero)
+	}
+	if _, err := NewInteger(10).TryPow(30); !errors.Is(err, ErrOverflow) {
+		t.Errorf("10^30 error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := NewFraction(1, 2).TryPow(math.MinInt); !errors.Is(err, ErrOverflow) {
+		t.Errorf("(1/2)^MinInt error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestFraction_TryPow_HugeExponent(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        *Fraction
+		exponent int
+		want     *Fraction
+	}{
+		{name: "Fraction_TryPow_HugeExponent_One", f: NewInteger(1), exponent: 1 << 62, want: NewInteger(1)},
+		{name: "Fraction_TryPow_HugeExponent_MinusOneEven", f: NewInteger(-1), exponent: 1 << 62, want: NewInteger(1)},
+		{name: "Fraction_TryPow_HugeExponent_MinusOneOdd", f: NewInteger(-1), exponent: 1<<62 + 1, want: NewInteger(-1)},
+		{name: "Fraction_TryPow_HugeExponent_Zero", f: NewInteger(0), exponent: math.MaxInt, want: NewInteger(0)},
+		{name: "Fraction_TryPow_HugeExponent_Unsimplified", f: NewFraction(3, 3), exponent: math.MaxInt, want: NewInteger(1)},
+		{name: "Fraction_TryPow_HugeExponent_MinInt", f: NewInteger(-1), exponent: math.MinInt, want: NewInteger(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f.TryPow(tt.exponent)
+			if err != nil {
+				t.Fatalf("TryPow() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("(%s)^%d = %s, want %s", tt.f, tt.exponent, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewInteger(2).TryPow(1 << 62); !errors.Is(err, ErrOverflow) {
+		t.Errorf("2^(2^62) error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestFraction_PowFraction(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        *Fraction
+		exponent *Fraction
+		want     string
+		rational bool
+	}{
+		{name: "Fraction_PowFraction_CubeRoot", f: NewFraction(8, 27), exponent: NewFraction(1, 3), want: "2/3", rational: true},
+		{name: "Fraction_PowFraction_TwoThirds", f: NewFraction(8, 27), exponent: NewFraction(2, 3), want: "4/9", rational: true},
+		{name: "Fraction_PowFraction_NegativeExponent", f: NewFraction(4, 9), exponent: NewFraction(-1, 2), want: "3/2", rational: true},
+		{name: "Fraction_PowFraction_Integer", f: NewFraction(2, 3), exponent: NewInteger(2), want: "4/9", rational: true},
+		{name: "Fraction_PowFraction_Irrational", f: NewInteger(2), exponent: NewFraction(1, 2), want: "√2"},
+		{name: "Fraction_PowFraction_RationalizedRoot", f: NewFraction(1, 2), exponent: NewFraction(1, 2), want: "(1/2)√2"},
+		{name: "Fraction_PowFraction_NegativeOddRoot", f: NewFraction(-1, 8), exponent: NewFraction(1, 3), want: "-1/2", rational: true},
+		{name: "Fraction_PowFraction_RootBeforePower", f: NewInteger(1 << 40), exponent: NewFraction(3, 2), want: "1152921504606846976", rational: true},
+		{name: "Fraction_PowFraction_Zero", f: NewInteger(0), exponent: NewFraction(2, 3), want: "0", rational: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f.PowFraction(tt.exponent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want || got.IsRational() != tt.rational {
+				t.Errorf("(%s)^(%s) = %s (rational %v), want %s (rational %v)", tt.f, tt.exponent, got, got.IsRational(), tt.want, tt.rational)
+			}
+		})
+	}
+
+	if _, err := NewInteger(-4).PowFraction(NewFraction(1, 2)); !errors.Is(err, ErrNegativeRadicand) {
+		t.Errorf("(-4)^(1/2) error = %v, want %v", err, ErrNegativeRadicand)
+	}
+}
+
+func TestFraction_Root(t *testing.T) {
+	tests := []struct {
+		name  string
+		f     *Fraction
+		index int
+		want  string
+	}{
+		{name: "Fraction_Root_Perfect", f: NewFraction(8, 27), index: 3, want: "2/3"},
+		{name: "Fraction_Root_Zero", f: NewInteger(0), index: 2, want: "0"},
+		{name: "Fraction_Root_Rationalized", f: NewFraction(1, 2), index: 2, want: "(1/2)√2"},
+		{name: "Fraction_Root_PartlyPerfectDenominator", f: NewFraction(1, 18), index: 2, want: "(1/6)√2"},
+		{name: "Fraction_Root_NegativeCube", f: NewFraction(-3, 4), index: 3, want: "(-1/2)∛6"},
+		{name: "Fraction_Root_LargePerfectDenominator", f: NewFraction(1, 4052555153018976267), index: 3, want: "1/1594323"},
+		{name: "Fraction_Root_LargeNumerator", f: NewFraction(4052555153018976267, 2), index: 3, want: "(1594323/2)∛4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f.Root(tt.index)
+			if err != nil {
+				t.Fatalf("Root() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Root(%s, %d) = %s, want %s", tt.f, tt.index, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPerfectPower(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        *Fraction
+		exponent int
+		want     bool
+	}{
+		{name: "PerfectPower_Square", f: NewFraction(9, 16), exponent: 2, want: true},
+		{name: "PerfectPower_NotSquare", f: NewFraction(2, 9), exponent: 2, want: false},
+		{name: "PerfectPower_NegativeSquare", f: NewFraction(-9, 16), exponent: 2, want: false},
+		{name: "PerfectPower_NegativeCube", f: NewFraction(-8, 27), exponent: 3, want: true},
+		{name: "PerfectPower_Unsimplified", f: NewFraction(18, 8), exponent: 2, want: true},
+		{name: "PerfectPower_Zero", f: NewInteger(0), exponent: 5, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.IsPerfectPower(tt.exponent); got != tt.want {
+				t.Errorf("(%s).IsPerfectPower(%d) = %v, want %v", tt.f, tt.exponent, got, tt.want)
+			}
+		})
+	}
+
+	if !NewFraction(25, 4).IsPerfectSquare() || NewInteger(8).IsPerfectSquare() {
+		t.Error("Fraction.IsPerfectSquare() is wrong")
+	}
+	if !IsPerfectSquare(144) || IsPerfectSquare(-4) || IsPerfectSquare(50) {
+		t.Error("IsPerfectSquare() is wrong")
+	}
+	if !IsPerfectPower(32, 5) || !IsPerfectPower(-27, 3) || IsPerfectPower(-16, 4) || IsPerfectPower(12, 2) {
+		t.Error("IsPerfectPower() is wrong")
+	}
+}
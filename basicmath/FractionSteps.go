@@ -0,0 +1,234 @@
+package basicmath
+
+import (
+	"fmt"
+	"math"
+	"mymath/latex"
+	"strings"
+)
+
+// #region Public Methods
+
+// AddWithSteps adds the fractions like Add and also returns the work: finding the least common denominator,
+// rewriting each fraction with it, combining the numerators and simplifying.
+// It panics with ErrZeroDenominator or ErrOverflow like Add; see TryAddWithSteps.
+func (f *Fraction) AddWithSteps(others ...*Fraction) (*Fraction, *StepLog) {
+	return mustSteps(f.TryAddWithSteps(others...))
+}
+
+// SubtractWithSteps subtracts the fractions like Subtract and also returns the work.
+// It panics with ErrZeroDenominator or ErrOverflow like Subtract; see TrySubtractWithSteps.
+func (f *Fraction) SubtractWithSteps(others ...*Fraction) (*Fraction, *StepLog) {
+	return mustSteps(f.TrySubtractWithSteps(others...))
+}
+
+// MultiplyWithSteps multiplies the fractions like Multiply and also returns the work: cancelling common
+// factors across the fractions, multiplying straight across and simplifying.
+// It panics with ErrZeroDenominator or ErrOverflow like Multiply; see TryMultiplyWithSteps.
+func (f *Fraction) MultiplyWithSteps(others ...*Fraction) (*Fraction, *StepLog) {
+	return mustSteps(f.TryMultiplyWithSteps(others...))
+}
+
+// DivideWithSteps divides the fractions like Divide and also returns the work: rewriting each division
+// as multiplication by the reciprocal, then the MultiplyWithSteps work.
+// It panics with ErrZeroDenominator, ErrDivisionByZero or ErrOverflow like Divide; see TryDivideWithSteps.
+func (f *Fraction) DivideWithSteps(others ...*Fraction) (*Fraction, *StepLog) {
+	return mustSteps(f.TryDivideWithSteps(others...))
+}
+
+// TryAddWithSteps is AddWithSteps returning ErrZeroDenominator or ErrOverflow instead of panicking
+func (f *Fraction) TryAddWithSteps(others ...*Fraction) (*Fraction, *StepLog, error) {
+	return fractionSteps(f, others, func(a, b *Fraction, steps *StepLog) (*Fraction, error) {
+		return addWithSteps(a, b, false, steps)
+	})
+}
+
+// TrySubtractWithSteps is SubtractWithSteps returning ErrZeroDenominator or ErrOverflow instead of panicking
+func (f *Fraction) TrySubtractWithSteps(others ...*Fraction) (*Fraction, *StepLog, error) {
+	return fractionSteps(f, others, func(a, b *Fraction, steps *StepLog) (*Fraction, error) {
+		return addWithSteps(a, b, true, steps)
+	})
+}
+
+// TryMultiplyWithSteps is MultiplyWithSteps returning ErrZeroDenominator or ErrOverflow instead of panicking
+func (f *Fraction) TryMultiplyWithSteps(others ...*Fraction) (*Fraction, *StepLog, error) {
+	return fractionSteps(f, others, multiplyWithSteps)
+}
+
+// TryDivideWithSteps is DivideWithSteps returning ErrZeroDenominator, ErrDivisionByZero or ErrOverflow
+// instead of panicking
+func (f *Fraction) TryDivideWithSteps(others ...*Fraction) (*Fraction, *StepLog, error) {
+	return fractionSteps(f, others, divideWithSteps)
+}
+
+// #endregion
+
+// #region Private Methods
+
+func mustSteps(f *Fraction, steps *StepLog, err error) (*Fraction, *StepLog) {
+	if err != nil {
+		panic(err)
+	}
+
+	return f, steps
+}
+
+// applies step to f and each of others in turn, checking every denominator first so the zero value
+// Fraction{} is ErrZeroDenominator rather than a runtime division by zero
+func fractionSteps(f *Fraction, others []*Fraction, step func(a, b *Fraction, steps *StepLog) (*Fraction, error)) (*Fraction, *StepLog, error) {
+	if f.d == 0 {
+		return nil, nil, ErrZeroDenominator
+	}
+	for _, other := range others {
+		if other.d == 0 {
+			return nil, nil, ErrZeroDenominator
+		}
+	}
+
+	steps := &StepLog{}
+	result := f
+	for _, other := range others {
+		var err error
+		if result, err = step(result, other, steps); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return result.simplifiedCopy(), steps, nil
+}
+
+func addWithSteps(a, b *Fraction, subtract bool, steps *StepLog) (*Fraction, error) {
+	operator, combine := "+", latex.ConnectWithPlusSign
+	if subtract {
+		operator, combine = "-", latex.ConnectWithMinusSign
+	}
+	problem := fmt.Sprintf("%s %s %s", a.LaTeX(), operator, parenthesizeLaTeX(b))
+
+	lcd, ok := multiplyInts(a.d/GCF(a.d, b.d), b.d)
+	if !ok {
+		return nil, ErrOverflow
+	}
+
+	left, leftOk := multiplyInts(a.n, lcd/a.d)
+	right, rightOk := multiplyInts(b.n, lcd/b.d)
+	if !leftOk || !rightOk {
+		return nil, ErrOverflow
+	}
+
+	if a.d == b.d {
+		steps.Add("the denominators are already the same", problem)
+	} else {
+		steps.Add("find the least common denominator", fmt.Sprintf(`\text{LCD}(%d, %d) = %d`, a.d, b.d, lcd))
+		for _, f := range []*Fraction{a, b} {
+			if f.d != lcd {
+				steps.Add("rewrite as an equivalent fraction", equivalentFractionLaTeX(f, lcd/f.d))
+			}
+		}
+		steps.Add("", fmt.Sprintf("%s = %s %s %s", problem,
+			(&Fraction{n: left, d: lcd}).LaTeX(), operator, parenthesizeLaTeX(&Fraction{n: right, d: lcd})))
+	}
+
+	var n int
+	if subtract {
+		if right == math.MinInt {
+			return nil, ErrOverflow
+		}
+		n, ok = addInts(left, -right)
+	} else {
+		n, ok = addInts(left, right)
+	}
+	if !ok {
+		return nil, ErrOverflow
+	}
+
+	combined := combine(fmt.Sprintf("%d", left), parenthesizeNegative(right))
+	steps.Add(combineDescription(subtract), fmt.Sprintf(`= \dfrac{%s}{%d} = %s`, combined, lcd, (&Fraction{n: n, d: lcd}).LaTeX()))
+
+	return simplifyWithSteps(n, lcd, steps), nil
+}
+
+func multiplyWithSteps(a, b *Fraction, steps *StepLog) (*Fraction, error) {
+	problem := fmt.Sprintf(`%s \cdot %s`, a.LaTeX(), parenthesizeLaTeX(b))
+
+	// cancel numerators against the opposite denominators before multiplying
+	left, right := &Fraction{n: a.n, d: a.d}, &Fraction{n: b.n, d: b.d}
+	cancellations := []string{}
+	if g := GCF(left.n, right.d); g > 1 && left.n != 0 {
+		cancellations = append(cancellations, fmt.Sprintf("%d from %d and %d", g, left.n, right.d))
+		left.n, right.d = left.n/g, right.d/g
+	}
+	if g := GCF(right.n, left.d); g > 1 && right.n != 0 {
+		cancellations = append(cancellations, fmt.Sprintf("%d from %d and %d", g, right.n, left.d))
+		right.n, left.d = right.n/g, left.d/g
+	}
+
+	if len(cancellations) > 0 {
+		steps.Add("cancel common factors: "+strings.Join(cancellations, ", "),
+			fmt.Sprintf(`%s = %s \cdot %s`, problem, left.LaTeX(), parenthesizeLaTeX(right)))
+		problem = fmt.Sprintf(`%s \cdot %s`, left.LaTeX(), parenthesizeLaTeX(right))
+	}
+
+	n, nOk := multiplyInts(left.n, right.n)
+	d, dOk := multiplyInts(left.d, right.d)
+	if !nOk || !dOk {
+		return nil, ErrOverflow
+	}
+
+	steps.Add("multiply the numerators and the denominators", fmt.Sprintf(`%s = \dfrac{%d \cdot %s}{%d \cdot %d} = %s`,
+		problem, left.n, parenthesizeNegative(right.n), left.d, right.d, (&Fraction{n: n, d: d}).LaTeX()))
+
+	return simplifyWithSteps(n, d, steps), nil
+}
+
+func divideWithSteps(a, b *Fraction, steps *StepLog) (*Fraction, error) {
+	if b.n == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	reciprocal, err := NewFractionE(b.d, b.n)
+	if err != nil {
+		return nil, err
+	}
+
+	steps.Add("multiply by the reciprocal", fmt.Sprintf(`%s \div %s = %s \cdot %s`,
+		a.LaTeX(), parenthesizeLaTeX(b), a.LaTeX(), parenthesizeLaTeX(reciprocal)))
+
+	return multiplyWithSteps(a, reciprocal, steps)
+}
+
+// records the division by the greatest common factor when n/d is not in lowest terms
+func simplifyWithSteps(n, d int, steps *StepLog) *Fraction {
+	result := &Fraction{n: n, d: d}
+	g := GCF(n, d)
+	if g <= 1 {
+		return result
+	}
+
+	result.Simplify()
+	steps.Add(fmt.Sprintf("divide by the greatest common factor %d", g), fmt.Sprintf(`%s = \dfrac{%d \div %d}{%d \div %d} = %s`,
+		(&Fraction{n: n, d: d}).LaTeX(), n, g, d, g, result.LaTeX()))
+
+	return result
+}
+
+func equivalentFractionLaTeX(f *Fraction, factor int) string {
+	return fmt.Sprintf(`%s = \dfrac{%s \cdot %d}{%d \cdot %d} = %s`,
+		f.LaTeX(), parenthesizeNegative(f.n), factor, f.d, factor, (&Fraction{n: f.n * factor, d: f.d * factor}).LaTeX())
+}
+
+func combineDescription(subtract bool) string {
+	if subtract {
+		return "subtract the numerators"
+	}
+	return "add the numerators"
+}
+
+// wraps negative values in parentheses so they read correctly after an operator
+func parenthesizeLaTeX(f *Fraction) string {
+	if f.n < 0 {
+		return fmt.Sprintf(`\left(%s\right)`, f.LaTeX())
+	}
+	return f.LaTeX()
+}
+
+// #endregion
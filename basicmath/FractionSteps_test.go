@@ -0,0 +1,125 @@
+package basicmath
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFraction_AddWithSteps(t *testing.T) {
+	got, steps := NewFraction(1, 4).AddWithSteps(NewFraction(5, 12))
+	if !got.Equals(NewFraction(2, 3)) {
+		t.Errorf("AddWithSteps() = %s, want 2/3", got)
+	}
+
+	want := []Step{
+		{Description: "find the least common denominator", Expression: `\text{LCD}(4, 12) = 12`},
+		{Description: "rewrite as an equivalent fraction", Expression: `\dfrac{1}{4} = \dfrac{1 \cdot 3}{4 \cdot 3} = \dfrac{3}{12}`},
+		{Description: "", Expression: `\dfrac{1}{4} + \dfrac{5}{12} = \dfrac{3}{12} + \dfrac{5}{12}`},
+		{Description: "add the numerators", Expression: `= \dfrac{3 + 5}{12} = \dfrac{8}{12}`},
+		{Description: "divide by the greatest common factor 4", Expression: `\dfrac{8}{12} = \dfrac{8 \div 4}{12 \div 4} = \dfrac{2}{3}`},
+	}
+	if !reflect.DeepEqual(steps.Steps(), want) {
+		t.Errorf("AddWithSteps() steps = %#v, want %#v", steps.Steps(), want)
+	}
+}
+
+func TestFraction_AddWithSteps_SameDenominator(t *testing.T) {
+	got, steps := NewFraction(1, 5).AddWithSteps(NewFraction(2, 5))
+	if !got.Equals(NewFraction(3, 5)) {
+		t.Errorf("AddWithSteps() = %s, want 3/5", got)
+	}
+
+	want := []Step{
+		{Description: "the denominators are already the same", Expression: `\dfrac{1}{5} + \dfrac{2}{5}`},
+		{Description: "add the numerators", Expression: `= \dfrac{1 + 2}{5} = \dfrac{3}{5}`},
+	}
+	if !reflect.DeepEqual(steps.Steps(), want) {
+		t.Errorf("AddWithSteps() steps = %#v, want %#v", steps.Steps(), want)
+	}
+}
+
+func TestFraction_SubtractWithSteps(t *testing.T) {
+	got, steps := NewFraction(1, 2).SubtractWithSteps(NewFraction(-1, 3))
+	if !got.Equals(NewFraction(5, 6)) {
+		t.Errorf("SubtractWithSteps() = %s, want 5/6", got)
+	}
+
+	last := steps.Steps()[steps.Len()-1]
+	if last.Expression != `= \dfrac{3 - (-2)}{6} = \dfrac{5}{6}` {
+		t.Errorf("last step = %q", last.Expression)
+	}
+}
+
+func TestFraction_MultiplyWithSteps(t *testing.T) {
+	got, steps := NewFraction(4, 9).MultiplyWithSteps(NewFraction(3, 8))
+	if !got.Equals(NewFraction(1, 6)) {
+		t.Errorf("MultiplyWithSteps() = %s, want 1/6", got)
+	}
+
+	want := []Step{
+		{Description: "cancel common factors: 4 from 4 and 8, 3 from 3 and 9", Expression: `\dfrac{4}{9} \cdot \dfrac{3}{8} = \dfrac{1}{3} \cdot \dfrac{1}{2}`},
+		{Description: "multiply the numerators and the denominators", Expression: `\dfrac{1}{3} \cdot \dfrac{1}{2} = \dfrac{1 \cdot 1}{3 \cdot 2} = \dfrac{1}{6}`},
+	}
+	if !reflect.DeepEqual(steps.Steps(), want) {
+		t.Errorf("MultiplyWithSteps() steps = %#v, want %#v", steps.Steps(), want)
+	}
+}
+
+func TestFraction_DivideWithSteps(t *testing.T) {
+	got, steps := NewFraction(2, 3).DivideWithSteps(NewFraction(-4, 5))
+	if !got.Equals(NewFraction(-5, 6)) {
+		t.Errorf("DivideWithSteps() = %s, want -5/6", got)
+	}
+
+	first := steps.Steps()[0]
+	if first.Expression != `\dfrac{2}{3} \div \left(-\dfrac{4}{5}\right) = \dfrac{2}{3} \cdot \left(-\dfrac{5}{4}\right)` {
+		t.Errorf("first step = %q", first.Expression)
+	}
+
+	wantLaTeX := "\\begin{align*}\n" +
+		"& \\dfrac{2}{3} \\div \\left(-\\dfrac{4}{5}\\right) = \\dfrac{2}{3} \\cdot \\left(-\\dfrac{5}{4}\\right) && \\text{multiply by the reciprocal} \\\\\n" +
+		"& \\dfrac{2}{3} \\cdot \\left(-\\dfrac{5}{4}\\right) = \\dfrac{1}{3} \\cdot \\left(-\\dfrac{5}{2}\\right) && \\text{cancel common factors: 2 from 2 and 4} \\\\\n" +
+		"& \\dfrac{1}{3} \\cdot \\left(-\\dfrac{5}{2}\\right) = \\dfrac{1 \\cdot (-5)}{3 \\cdot 2} = -\\dfrac{5}{6} && \\text{multiply the numerators and the denominators}\n" +
+		"\\end{align*}"
+	if got := steps.LaTeX(); got != wantLaTeX {
+		t.Errorf("DivideWithSteps() LaTeX = %q, want %q", got, wantLaTeX)
+	}
+}
+
+func TestFraction_DivideWithSteps_PanicsOnZero(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrDivisionByZero {
+			t.Errorf("DivideWithSteps() panic = %v, want %v", r, ErrDivisionByZero)
+		}
+	}()
+
+	NewFraction(1, 2).DivideWithSteps(NewInteger(0))
+}
+
+func TestFraction_TryWithSteps_ZeroValue(t *testing.T) {
+	tests := []struct {
+		name string
+		try  func() (*Fraction, *StepLog, error)
+	}{
+		{"Fraction_TryAddWithSteps_ZeroValueReceiver", func() (*Fraction, *StepLog, error) { return (&Fraction{}).TryAddWithSteps(NewInteger(1)) }},
+		{"Fraction_TrySubtractWithSteps_ZeroValueOperand", func() (*Fraction, *StepLog, error) { return NewInteger(1).TrySubtractWithSteps(&Fraction{}) }},
+		{"Fraction_TryMultiplyWithSteps_ZeroValueOperand", func() (*Fraction, *StepLog, error) { return NewInteger(1).TryMultiplyWithSteps(&Fraction{}) }},
+		{"Fraction_TryDivideWithSteps_ZeroValueOperand", func() (*Fraction, *StepLog, error) { return NewInteger(1).TryDivideWithSteps(&Fraction{}) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := tt.try(); !errors.Is(err, ErrZeroDenominator) {
+				t.Errorf("error = %v, want %v", err, ErrZeroDenominator)
+			}
+		})
+	}
+
+	defer func() {
+		if r := recover(); r != ErrZeroDenominator {
+			t.Errorf("AddWithSteps() panic = %v, want %v", r, ErrZeroDenominator)
+		}
+	}()
+
+	NewInteger(1).AddWithSteps(&Fraction{})
+}
@@ -1,6 +1,8 @@
 package basicmath
 
 import (
+	"errors"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -634,3 +636,184 @@ func Test_getFractionGCF(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFractionE(t *testing.T) {
+	tests := []struct {
+		name        string
+		numerator   int
+		denominator int
+		want        *Fraction
+		wantErr     error
+	}{
+		{
+			name:        "Fraction_NewFractionE_Test01",
+			numerator:   3,
+			denominator: 4,
+			want:        NewFraction(3, 4),
+		},
+		{
+			name:        "Fraction_NewFractionE_Test02",
+			numerator:   3,
+			denominator: -4,
+			want:        NewFraction(-3, 4),
+		},
+		{
+			name:        "Fraction_NewFractionE_ZeroDenominator",
+			numerator:   3,
+			denominator: 0,
+			wantErr:     ErrZeroDenominator,
+		},
+		{
+			name:        "Fraction_NewFractionE_Overflow",
+			numerator:   math.MinInt,
+			denominator: -1,
+			wantErr:     ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFractionE(tt.numerator, tt.denominator)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewFractionE() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFractionE() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction_TryOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      func() (*Fraction, error)
+		want    *Fraction
+		wantErr error
+	}{
+		{
+			name:    "Fraction_TryDivide_ByZero",
+			op:      func() (*Fraction, error) { return NewFraction(3, 4).TryDivide(NewInteger(0)) },
+			wantErr: ErrDivisionByZero,
+		},
+		{
+			name: "Fraction_TryDivide_Test01",
+			op:   func() (*Fraction, error) { return NewFraction(3, 4).TryDivide(NewFraction(-1, 2)) },
+			want: NewFraction(-3, 2),
+		},
+		{
+			name:    "Fraction_TryMultiply_Overflow",
+			op:      func() (*Fraction, error) { return NewInteger(math.MaxInt / 2).TryMultiply(NewInteger(3)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name: "Fraction_TryMultiply_CrossCancel",
+			op: func() (*Fraction, error) {
+				return NewFraction(math.MaxInt, 2).TryMultiply(NewFraction(2, math.MaxInt))
+			},
+			want: NewInteger(1),
+		},
+		{
+			name:    "Fraction_TryAdd_Overflow",
+			op:      func() (*Fraction, error) { return NewInteger(math.MaxInt).TryAdd(NewInteger(1)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name:    "Fraction_TryAdd_DenominatorOverflow",
+			op:      func() (*Fraction, error) { return NewFraction(1, math.MaxInt).TryAdd(NewFraction(1, math.MaxInt-1)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name:    "Fraction_TrySubtract_Overflow",
+			op:      func() (*Fraction, error) { return NewInteger(math.MinInt + 1).TrySubtract(NewInteger(2)) },
+			wantErr: ErrOverflow,
+		},
+		{
+			name: "Fraction_TrySubtract_Test01",
+			op:   func() (*Fraction, error) { return NewFraction(1, 2).TrySubtract(NewFraction(1, 3)) },
+			want: NewFraction(1, 6),
+		},
+		{
+			name:    "Fraction_TryAdd_ZeroValueReceiver",
+			op:      func() (*Fraction, error) { return (&Fraction{}).TryAdd(NewInteger(1)) },
+			wantErr: ErrZeroDenominator,
+		},
+		{
+			name:    "Fraction_TryAdd_ZeroValueOperand",
+			op:      func() (*Fraction, error) { return NewInteger(1).TryAdd(&Fraction{}) },
+			wantErr: ErrZeroDenominator,
+		},
+		{
+			name:    "Fraction_TrySubtract_ZeroValue",
+			op:      func() (*Fraction, error) { return (&Fraction{}).TrySubtract(NewInteger(1)) },
+			wantErr: ErrZeroDenominator,
+		},
+		{
+			name:    "Fraction_TrySubtract_ZeroValueOperand",
+			op:      func() (*Fraction, error) { return NewInteger(1).TrySubtract(&Fraction{}) },
+			wantErr: ErrZeroDenominator,
+		},
+		{
+			name:    "Fraction_TryMultiply_ZeroValue",
+			op:      func() (*Fraction, error) { return (&Fraction{}).TryMultiply(NewInteger(2)) },
+			wantErr: ErrZeroDenominator,
+		},
+		{
+			name:    "Fraction_TryMultiply_ZeroValueOperand",
+			op:      func() (*Fraction, error) { return NewInteger(2).TryMultiply(&Fraction{}) },
+			wantErr: ErrZeroDenominator,
+		},
+		{
+			name:    "Fraction_TryDivide_ZeroValue",
+			op:      func() (*Fraction, error) { return (&Fraction{}).TryDivide(NewInteger(2)) },
+			wantErr: ErrZeroDenominator,
+		},
+		{
+			name:    "Fraction_TryDivide_ZeroValueOperand",
+			op:      func() (*Fraction, error) { return NewInteger(2).TryDivide(&Fraction{}) },
+			wantErr: ErrZeroDenominator,
+		},
+		{
+			name: "Fraction_TryPlusFloat_Test01",
+			op:   func() (*Fraction, error) { return NewFraction(1, 4).TryPlusFloat(0.5) },
+			want: NewFraction(3, 4),
+		},
+		{
+			name:    "Fraction_TryPlusFloat_NaN",
+			op:      func() (*Fraction, error) { return NewInteger(1).TryPlusFloat(math.NaN()) },
+			wantErr: ErrNotFinite,
+		},
+		{
+			name:    "Fraction_TryMultiplyByFactor_Infinity",
+			op:      func() (*Fraction, error) { return NewInteger(1).TryMultiplyByFactor(math.Inf(-1)) },
+			wantErr: ErrNotFinite,
+		},
+		{
+			name:    "Fraction_TryDividedByFloat_Overflow",
+			op:      func() (*Fraction, error) { return NewInteger(1).TryDividedByFloat(1e20) },
+			wantErr: ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction_Divide_PanicsOnZero(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrDivisionByZero {
+			t.Errorf("Fraction.Divide() panic = %v, want %v", r, ErrDivisionByZero)
+		}
+	}()
+
+	NewFraction(3, 4).Divide(NewInteger(0))
+}
@@ -116,10 +116,11 @@ func getFactorsOf(n int) map[*Fraction]*Fraction {
 	n = Abs(n)
 	factors := make(map[*Fraction]*Fraction)
 
-	for i := 1; i <= (int(math.Sqrt(float64(n)))); i++ {
-		if n%i == 0 {
-			factors[NewInteger(i)] = NewInteger(n / i)
+	for _, divisor := range Divisors(n) {
+		if divisor > n/divisor {
+			break
 		}
+		factors[NewInteger(divisor)] = NewInteger(n / divisor)
 	}
 
 	return factors
@@ -153,4 +154,28 @@ func getMin(a, b int) int {
 	return b
 }
 
+// returns a+b and whether the sum fits in an int
+func addInts(a, b int) (int, bool) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return sum, false
+	}
+
+	return sum, true
+}
+
+// returns a*b and whether the product fits in an int
+func multiplyInts(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return product, false
+	}
+
+	return product, true
+}
+
 // #endregion
@@ -0,0 +1,294 @@
+package basicmath
+
+import (
+	"fmt"
+	"math"
+	"mymath/latex"
+	"strconv"
+	"strings"
+)
+
+// LongDivisionStep is one divide, multiply, subtract and bring down cycle of long division
+type LongDivisionStep struct {
+	// Partial is the number being divided, ending at Column of the dividend
+	Partial int
+	// QuotientDigit is the digit written above Column
+	QuotientDigit int
+	// Product is QuotientDigit × divisor, subtracted from Partial
+	Product int
+	// Difference is Partial - Product
+	Difference int
+	// BroughtDown is the next dividend digit written beside Difference, or -1 after the last step
+	BroughtDown int
+	// Column is the index of the dividend digit, counting any zeros written after the decimal point
+	Column int
+}
+
+// LongDivisionResult is the worked long division of two integers.
+// The division works on absolute values; Quotient and Remainder carry Go's signs (truncated division).
+type LongDivisionResult struct {
+	Dividend  int
+	Divisor   int
+	Quotient  int
+	Remainder int
+	// Decimal is the quotient written to the requested number of decimal places (truncated), e.g. "3.1428"
+	Decimal string
+	// Terminates reports whether the decimal expansion ended within the requested places
+	Terminates bool
+	Steps      []LongDivisionStep
+
+	digits         []int // dividend digits followed by the zeros brought down after the decimal point
+	integerDigits  int
+	quotientDigits []int // quotient digit above each column, or -1 for an empty column
+}
+
+// #region Public Methods
+
+// LongDivision divides two integers by long division, recording every step
+func LongDivision(dividend, divisor int) (*LongDivisionResult, error) {
+	return LongDivisionDecimal(dividend, divisor, 0)
+}
+
+// LongDivisionDecimal continues the long division past the decimal point for up to places digits,
+// stopping early when the remainder becomes zero
+func LongDivisionDecimal(dividend, divisor, places int) (*LongDivisionResult, error) {
+	if divisor == 0 {
+		return nil, ErrDivisionByZero
+	}
+	if dividend == math.MinInt || divisor == math.MinInt {
+		return nil, ErrOverflow
+	}
+	if places < 0 {
+		places = 0
+	}
+
+	result := &LongDivisionResult{
+		Dividend:  dividend,
+		Divisor:   divisor,
+		Quotient:  dividend / divisor,
+		Remainder: dividend % divisor,
+	}
+
+	d := Abs(divisor)
+	for _, r := range strconv.Itoa(Abs(dividend)) {
+		result.digits = append(result.digits, int(r-'0'))
+	}
+	result.integerDigits = len(result.digits)
+	for i := 0; i < places; i++ {
+		result.digits = append(result.digits, 0)
+	}
+
+	remainder := 0
+	started := false
+	used := len(result.digits)
+
+	for i, digit := range result.digits {
+		shifted, ok := multiplyInts(remainder, 10)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		partial := shifted + digit
+		q := partial / d
+
+		if !started && q == 0 {
+			// leading zeros are left blank, except for the units digit
+			if i < result.integerDigits-1 {
+				result.quotientDigits = append(result.quotientDigits, -1)
+			} else {
+				result.quotientDigits = append(result.quotientDigits, 0)
+			}
+
+			remainder = partial
+			if remainder == 0 && i >= result.integerDigits-1 {
+				used = i + 1
+				break
+			}
+			continue
+		}
+
+		result.quotientDigits = append(result.quotientDigits, q)
+		started = true
+		remainder = partial - q*d
+		result.Steps = append(result.Steps, LongDivisionStep{
+			Partial:       partial,
+			QuotientDigit: q,
+			Product:       q * d,
+			Difference:    remainder,
+			BroughtDown:   -1,
+			Column:        i,
+		})
+
+		if remainder == 0 && i >= result.integerDigits-1 {
+			used = i + 1
+			break
+		}
+	}
+
+	result.digits = result.digits[:used]
+	result.Terminates = remainder == 0
+
+	for i := range result.Steps[:Max(len(result.Steps)-1, 0)] {
+		result.Steps[i].BroughtDown = result.digits[result.Steps[i].Column+1]
+	}
+
+	result.Decimal = result.decimalString()
+
+	return result, nil
+}
+
+// DecimalDivision divides two decimal numbers such as "7.5" and "0.25" by moving both decimal points
+// until they are whole numbers and then dividing by long division to up to places decimal places
+func DecimalDivision(dividend, divisor string, places int) (*LongDivisionResult, *StepLog, error) {
+	dividendDigits, dividendPlaces, err := parseDecimalDigits(dividend)
+	if err != nil {
+		return nil, nil, err
+	}
+	divisorDigits, divisorPlaces, err := parseDecimalDigits(divisor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shift := Max(dividendPlaces, divisorPlaces)
+	a, err := strconv.Atoi(dividendDigits + strings.Repeat("0", shift-dividendPlaces))
+	if err != nil {
+		return nil, nil, ErrOverflow
+	}
+	b, err := strconv.Atoi(divisorDigits + strings.Repeat("0", shift-divisorPlaces))
+	if err != nil {
+		return nil, nil, ErrOverflow
+	}
+
+	steps := &StepLog{}
+	if shift > 0 {
+		steps.Add(fmt.Sprintf("move both decimal points %d places to the right", shift),
+			fmt.Sprintf(`%s \div %s = %d \div %d`, strings.TrimSpace(dividend), strings.TrimSpace(divisor), a, b))
+	}
+
+	result, err := LongDivisionDecimal(a, b, places)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	steps.Add("divide by long division", fmt.Sprintf(`%d \div %d = %s`, a, b, result.approximation()))
+
+	return result, steps, nil
+}
+
+// LaTeX lays the division out as a tabular with the quotient above the dividend and each
+// subtraction underneath, one digit per column
+func (r *LongDivisionResult) LaTeX() string {
+	columns := len(r.digits)
+	row := func() []string {
+		return make([]string, columns+1)
+	}
+
+	quotient := row()
+	dividend := row()
+	dividend[0] = fmt.Sprintf(`%d\,\big)`, Abs(r.Divisor))
+	for i, digit := range r.digits {
+		dividend[i+1] = strconv.Itoa(digit)
+		if r.quotientDigits[i] >= 0 {
+			quotient[i+1] = strconv.Itoa(r.quotientDigits[i])
+		}
+		if i == r.integerDigits-1 && columns > r.integerDigits {
+			dividend[i+1] += "."
+			quotient[i+1] += "."
+		}
+	}
+
+	rows := [][]string{quotient, {latex.Cline(2, columns+1)}, dividend}
+
+	for i, step := range r.Steps {
+		product := row()
+		start := placeDigits(product, step.Product, step.Column)
+		product[start-1] = "$-$"
+
+		partialStart := step.Column - len(strconv.Itoa(step.Partial)) + 1
+		rows = append(rows, product, []string{latex.Cline(partialStart+2, step.Column+2)})
+
+		next := row()
+		if i+1 < len(r.Steps) {
+			placeDigits(next, r.Steps[i+1].Partial, r.Steps[i+1].Column)
+		} else {
+			placeDigits(next, step.Difference, step.Column)
+		}
+		rows = append(rows, next)
+	}
+
+	return latex.Tabular("r"+strings.Repeat("c", columns), rows...)
+}
+
+// Fraction returns the exact quotient as a fraction
+func (r *LongDivisionResult) Fraction() *Fraction {
+	return NewFraction(r.Dividend, r.Divisor).simplifiedCopy()
+}
+
+// #endregion
+
+// #region Private Methods
+
+// writes the digits of value into row so the last digit lands in column end, returning the row index of the first digit
+func placeDigits(row []string, value int, end int) int {
+	text := strconv.Itoa(value)
+	start := end - len(text) + 1
+	for i, r := range text {
+		row[start+i+1] = string(r)
+	}
+	return start + 1
+}
+
+// writes the quotient as a decimal; a negative quotient that rounds to zero, such as -1 ÷ 1000 to two
+// places, is written without a minus sign
+func (r *LongDivisionResult) decimalString() string {
+	var sb strings.Builder
+
+	sb.WriteString(strconv.Itoa(Abs(r.Quotient)))
+	if len(r.digits) > r.integerDigits {
+		sb.WriteString(".")
+		for _, digit := range r.quotientDigits[r.integerDigits:] {
+			sb.WriteString(strconv.Itoa(digit))
+		}
+	}
+
+	text := sb.String()
+	if (r.Dividend < 0) != (r.Divisor < 0) && strings.Trim(text, "0.") != "" {
+		return "-" + text
+	}
+	return text
+}
+
+// the decimal with \ldots appended when the expansion was cut short
+func (r *LongDivisionResult) approximation() string {
+	if r.Terminates {
+		return r.Decimal
+	}
+	return r.Decimal + `\ldots`
+}
+
+// returns the digits of a decimal number without its point and how many were after the point
+func parseDecimalDigits(s string) (string, int, error) {
+	text := strings.TrimSpace(strings.ReplaceAll(s, "−", "-"))
+	negative := strings.HasPrefix(text, "-")
+	if negative {
+		text = strings.TrimPrefix(text, "-")
+	} else {
+		text = strings.TrimPrefix(text, "+")
+	}
+
+	whole, fraction, _ := strings.Cut(text, ".")
+	if whole == "" {
+		whole = "0"
+	}
+	if !isDigits(whole, 10) || (fraction != "" && !isDigits(fraction, 10)) {
+		return "", 0, fmt.Errorf("basicmath: invalid decimal %q", s)
+	}
+
+	digits := whole + fraction
+	if negative {
+		digits = "-" + digits
+	}
+
+	return digits, len(fraction), nil
+}
+
+// #endregion
@@ -0,0 +1,111 @@
+package basicmath
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestLongDivision(t *testing.T) {
+	got, err := LongDivision(1234, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Quotient != 246 || got.Remainder != 4 || got.Decimal != "246" || got.Terminates {
+		t.Errorf("LongDivision(1234, 5) = %d R %d (%s, terminates %v), want 246 R 4", got.Quotient, got.Remainder, got.Decimal, got.Terminates)
+	}
+
+	wantSteps := []LongDivisionStep{
+		{Partial: 12, QuotientDigit: 2, Product: 10, Difference: 2, BroughtDown: 3, Column: 1},
+		{Partial: 23, QuotientDigit: 4, Product: 20, Difference: 3, BroughtDown: 4, Column: 2},
+		{Partial: 34, QuotientDigit: 6, Product: 30, Difference: 4, BroughtDown: -1, Column: 3},
+	}
+	if !reflect.DeepEqual(got.Steps, wantSteps) {
+		t.Errorf("LongDivision(1234, 5).Steps = %+v, want %+v", got.Steps, wantSteps)
+	}
+
+	wantLaTeX := "\\begin{tabular}{rcccc}\n" +
+		" &  & 2 & 4 & 6 \\\\\n" +
+		"\\cline{2-5}\n" +
+		"5\\,\\big) & 1 & 2 & 3 & 4 \\\\\n" +
+		"$-$ & 1 & 0 &  &  \\\\\n" +
+		"\\cline{2-3}\n" +
+		" &  & 2 & 3 &  \\\\\n" +
+		" & $-$ & 2 & 0 &  \\\\\n" +
+		"\\cline{3-4}\n" +
+		" &  &  & 3 & 4 \\\\\n" +
+		" &  & $-$ & 3 & 0 \\\\\n" +
+		"\\cline{4-5}\n" +
+		" &  &  &  & 4 \\\\\n" +
+		"\\end{tabular}"
+	if latex := got.LaTeX(); latex != wantLaTeX {
+		t.Errorf("LongDivision(1234, 5).LaTeX() = %q, want %q", latex, wantLaTeX)
+	}
+}
+
+func TestLongDivisionDecimal(t *testing.T) {
+	tests := []struct {
+		name           string
+		dividend       int
+		divisor        int
+		places         int
+		wantDecimal    string
+		wantTerminates bool
+	}{
+		{name: "LongDivisionDecimal_Repeating", dividend: 22, divisor: 7, places: 4, wantDecimal: "3.1428"},
+		{name: "LongDivisionDecimal_Terminating", dividend: 7, divisor: 4, places: 5, wantDecimal: "1.75", wantTerminates: true},
+		{name: "LongDivisionDecimal_SmallDividend", dividend: 1, divisor: 70, places: 3, wantDecimal: "0.014"},
+		{name: "LongDivisionDecimal_Negative", dividend: -1, divisor: 8, places: 3, wantDecimal: "-0.125", wantTerminates: true},
+		{name: "LongDivisionDecimal_NegativeRoundsToZero", dividend: -1, divisor: 1000, places: 2, wantDecimal: "0.00"},
+		{name: "LongDivisionDecimal_NegativeWholeRoundsToZero", dividend: -1, divisor: 3, places: 0, wantDecimal: "0"},
+		{name: "LongDivisionDecimal_ZeroInQuotient", dividend: 1005, divisor: 5, places: 2, wantDecimal: "201", wantTerminates: true},
+		{name: "LongDivisionDecimal_Zero", dividend: 0, divisor: 3, places: 2, wantDecimal: "0", wantTerminates: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LongDivisionDecimal(tt.dividend, tt.divisor, tt.places)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Decimal != tt.wantDecimal || got.Terminates != tt.wantTerminates {
+				t.Errorf("LongDivisionDecimal(%d, %d, %d) = %s (terminates %v), want %s (terminates %v)",
+					tt.dividend, tt.divisor, tt.places, got.Decimal, got.Terminates, tt.wantDecimal, tt.wantTerminates)
+			}
+		})
+	}
+
+	if _, err := LongDivision(1, 0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("LongDivision(1, 0) error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestDecimalDivision(t *testing.T) {
+	got, steps, err := DecimalDivision("7.5", "0.25", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Decimal != "30" {
+		t.Errorf("DecimalDivision(7.5, 0.25) = %s, want 30", got.Decimal)
+	}
+
+	want := []Step{
+		{Description: "move both decimal points 2 places to the right", Expression: `7.5 \div 0.25 = 750 \div 25`},
+		{Description: "divide by long division", Expression: `750 \div 25 = 30`},
+	}
+	if !reflect.DeepEqual(steps.Steps(), want) {
+		t.Errorf("DecimalDivision() steps = %#v, want %#v", steps.Steps(), want)
+	}
+
+	got, steps, err = DecimalDivision("1", "0.3", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Decimal != "3.333" || steps.Steps()[1].Expression != `10 \div 3 = 3.333\ldots` {
+		t.Errorf("DecimalDivision(1, 0.3) = %s, steps %v", got.Decimal, steps.Steps())
+	}
+
+	if _, _, err := DecimalDivision("1.2.3", "2", 1); err == nil {
+		t.Error("DecimalDivision() with an invalid decimal should fail")
+	}
+}
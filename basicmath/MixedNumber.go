@@ -0,0 +1,257 @@
+package basicmath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// #region Constructor
+
+// MixedNumber represents a value as a whole number and a proper fraction, e.g. 3 1/2.
+// The sign applies to the whole value, so -3 1/2 means -(3 + 1/2).
+type MixedNumber struct {
+	negative  bool
+	whole     int
+	remainder *Fraction
+}
+
+// NewMixedNumber builds |whole| + |numerator|/denominator, negative when whole is negative
+// (or, for a zero whole part, when the numerator is). An improper remainder is carried into the whole part.
+func NewMixedNumber(whole int, numerator int, denominator int) *MixedNumber {
+	remainder := NewFraction(Abs(numerator), Abs(denominator))
+	negative := whole < 0 || (whole == 0 && numerator < 0)
+
+	improper := NewInteger(Abs(whole)).Add(remainder)
+	if negative {
+		improper = improper.Multiply(NewInteger(-1))
+	}
+
+	return improper.ToMixedNumber()
+}
+
+// ToMixedNumber converts an improper fraction such as 7/2 to 3 1/2
+func (f *Fraction) ToMixedNumber() *MixedNumber {
+	temp := f.simplifiedCopy()
+	n := Abs(temp.n)
+
+	return &MixedNumber{
+		negative:  temp.n < 0,
+		whole:     n / temp.d,
+		remainder: NewFraction(n%temp.d, temp.d),
+	}
+}
+
+// #endregion
+
+// #region Properties
+
+// Whole returns the signed whole-number part, e.g. -3 for -3 1/2
+func (m *MixedNumber) Whole() int {
+	if m.negative {
+		return -m.whole
+	}
+	return m.whole
+}
+
+// Remainder returns the non-negative proper fraction part, e.g. 1/2 for -3 1/2
+func (m *MixedNumber) Remainder() *Fraction {
+	return NewFraction(m.remainder.n, m.remainder.d)
+}
+
+func (m *MixedNumber) IsNegative() bool {
+	return m.negative
+}
+
+// #endregion
+
+// #region Comparable
+
+func (m *MixedNumber) Compare(other *MixedNumber) int {
+	return m.ToImproperFraction().Compare(other.ToImproperFraction())
+}
+
+func (m *MixedNumber) Equals(other *MixedNumber) bool {
+	return m.Compare(other) == 0
+}
+
+func (m *MixedNumber) GreaterThan(other *MixedNumber) bool {
+	return m.Compare(other) > 0
+}
+
+func (m *MixedNumber) GreaterThanOrEqualTo(other *MixedNumber) bool {
+	return m.Compare(other) >= 0
+}
+
+func (m *MixedNumber) LessThan(other *MixedNumber) bool {
+	return m.Compare(other) < 0
+}
+
+func (m *MixedNumber) LessThanOrEqualTo(other *MixedNumber) bool {
+	return m.Compare(other) <= 0
+}
+
+// #endregion
+
+// #region LaTeXer
+
+func (m *MixedNumber) LaTeX() string {
+	var sb strings.Builder
+
+	if m.negative {
+		sb.WriteString("-")
+	}
+
+	if m.whole != 0 || m.remainder.n == 0 {
+		sb.WriteString(strconv.Itoa(m.whole))
+	}
+
+	if m.remainder.n != 0 {
+		sb.WriteString(m.remainder.LaTeX())
+	}
+
+	return sb.String()
+}
+
+// #endregion
+
+// #region Operable
+
+func (m *MixedNumber) Add(others ...*MixedNumber) *MixedNumber {
+	return m.ToImproperFraction().Add(improperFractions(others)...).ToMixedNumber()
+}
+
+func (m *MixedNumber) Divide(others ...*MixedNumber) *MixedNumber {
+	return m.ToImproperFraction().Divide(improperFractions(others)...).ToMixedNumber()
+}
+
+func (m *MixedNumber) Multiply(others ...*MixedNumber) *MixedNumber {
+	return m.ToImproperFraction().Multiply(improperFractions(others)...).ToMixedNumber()
+}
+
+func (m *MixedNumber) Subtract(others ...*MixedNumber) *MixedNumber {
+	return m.ToImproperFraction().Subtract(improperFractions(others)...).ToMixedNumber()
+}
+
+// #endregion
+
+// #region Simplifiable
+
+func (m *MixedNumber) Simplify() {
+	m.remainder.Simplify()
+}
+
+// #endregion
+
+// #region Stringer
+
+func (m *MixedNumber) String() string {
+	var sb strings.Builder
+
+	if m.negative {
+		sb.WriteString("-")
+	}
+
+	if m.whole != 0 || m.remainder.n == 0 {
+		sb.WriteString(strconv.Itoa(m.whole))
+	}
+
+	if m.remainder.n != 0 {
+		if m.whole != 0 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(m.remainder.String())
+	}
+
+	return sb.String()
+}
+
+// #endregion
+
+// #region Public Methods
+
+// ToImproperFraction converts back, e.g. 3 1/2 becomes 7/2
+func (m *MixedNumber) ToImproperFraction() *Fraction {
+	improper := NewInteger(m.whole).Add(m.remainder)
+	if m.negative {
+		return improper.Multiply(NewInteger(-1))
+	}
+
+	return improper
+}
+
+// ParseMixedNumber reads text such as "3 1/2", "-3 1/2", "7/2" or "3"
+func ParseMixedNumber(s string) (*MixedNumber, error) {
+	fields := strings.Fields(s)
+
+	switch len(fields) {
+	case 1:
+		if strings.Contains(fields[0], "/") {
+			f, err := parseSimpleFraction(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("basicmath: invalid mixed number %q: %w", s, err)
+			}
+			return f.ToMixedNumber(), nil
+		}
+
+		whole, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("basicmath: invalid mixed number %q", s)
+		}
+		return NewInteger(whole).ToMixedNumber(), nil
+	case 2:
+		whole, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("basicmath: invalid whole part in mixed number %q", s)
+		}
+
+		remainder, err := parseSimpleFraction(fields[1])
+		if err != nil || remainder.n < 0 {
+			return nil, fmt.Errorf("basicmath: invalid fraction part in mixed number %q", s)
+		}
+
+		negative := strings.HasPrefix(fields[0], "-")
+		improper := NewInteger(Abs(whole)).Add(remainder)
+		if negative {
+			improper = improper.Multiply(NewInteger(-1))
+		}
+		return improper.ToMixedNumber(), nil
+	}
+
+	return nil, fmt.Errorf("basicmath: invalid mixed number %q", s)
+}
+
+// #endregion
+
+// #region Private Methods
+
+func improperFractions(mixed []*MixedNumber) []*Fraction {
+	fractions := make([]*Fraction, 0, len(mixed))
+	for _, m := range mixed {
+		fractions = append(fractions, m.ToImproperFraction())
+	}
+	return fractions
+}
+
+// parses "n/d" or "n" made of plain integers
+func parseSimpleFraction(s string) (*Fraction, error) {
+	numerator, denominator, hasSlash := strings.Cut(s, "/")
+
+	n, err := strconv.Atoi(numerator)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasSlash {
+		return NewInteger(n), nil
+	}
+
+	d, err := strconv.Atoi(denominator)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFractionE(n, d)
+}
+
+// #endregion
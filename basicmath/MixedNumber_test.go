@@ -0,0 +1,118 @@
+package basicmath
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFraction_ToMixedNumber(t *testing.T) {
+	tests := []struct {
+		name      string
+		f         *Fraction
+		wantText  string
+		wantLaTeX string
+	}{
+		{name: "MixedNumber_Test01", f: NewFraction(7, 2), wantText: "3 1/2", wantLaTeX: `3\dfrac{1}{2}`},
+		{name: "MixedNumber_Test02", f: NewFraction(-7, 2), wantText: "-3 1/2", wantLaTeX: `-3\dfrac{1}{2}`},
+		{name: "MixedNumber_Test03", f: NewFraction(-1, 2), wantText: "-1/2", wantLaTeX: `-\dfrac{1}{2}`},
+		{name: "MixedNumber_Test04", f: NewFraction(12, 4), wantText: "3", wantLaTeX: `3`},
+		{name: "MixedNumber_Test05", f: NewInteger(0), wantText: "0", wantLaTeX: `0`},
+		{name: "MixedNumber_Test06", f: NewFraction(22, 6), wantText: "3 2/3", wantLaTeX: `3\dfrac{2}{3}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.f.ToMixedNumber()
+			if got.String() != tt.wantText {
+				t.Errorf("MixedNumber.String() = %v, want %v", got.String(), tt.wantText)
+			}
+			if got.LaTeX() != tt.wantLaTeX {
+				t.Errorf("MixedNumber.LaTeX() = %v, want %v", got.LaTeX(), tt.wantLaTeX)
+			}
+			if !got.ToImproperFraction().Equals(tt.f) {
+				t.Errorf("MixedNumber.ToImproperFraction() = %v, want %v", got.ToImproperFraction(), tt.f)
+			}
+		})
+	}
+}
+
+func TestNewMixedNumber(t *testing.T) {
+	tests := []struct {
+		name      string
+		whole     int
+		numerator int
+		denom     int
+		wantWhole int
+		wantRem   *Fraction
+	}{
+		{name: "MixedNumber_New_Test01", whole: 3, numerator: 1, denom: 2, wantWhole: 3, wantRem: NewFraction(1, 2)},
+		{name: "MixedNumber_New_Test02", whole: -3, numerator: 1, denom: 2, wantWhole: -3, wantRem: NewFraction(1, 2)},
+		{name: "MixedNumber_New_Carry", whole: 2, numerator: 7, denom: 4, wantWhole: 3, wantRem: NewFraction(3, 4)},
+		{name: "MixedNumber_New_Reduce", whole: 1, numerator: 2, denom: 4, wantWhole: 1, wantRem: NewFraction(1, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewMixedNumber(tt.whole, tt.numerator, tt.denom)
+			if got.Whole() != tt.wantWhole || !reflect.DeepEqual(got.Remainder(), tt.wantRem) {
+				t.Errorf("NewMixedNumber() = %v, want whole %v remainder %v", got, tt.wantWhole, tt.wantRem)
+			}
+		})
+	}
+}
+
+func TestMixedNumber_Operations(t *testing.T) {
+	a := NewMixedNumber(2, 1, 3)
+	b := NewMixedNumber(1, 1, 2)
+
+	tests := []struct {
+		name string
+		got  *MixedNumber
+		want string
+	}{
+		{name: "MixedNumber_Add_Test01", got: a.Add(b), want: "3 5/6"},
+		{name: "MixedNumber_Subtract_Test01", got: b.Subtract(a), want: "-5/6"},
+		{name: "MixedNumber_Multiply_Test01", got: a.Multiply(b), want: "3 1/2"},
+		{name: "MixedNumber_Divide_Test01", got: a.Divide(b), want: "1 5/9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+
+	if !a.GreaterThan(b) || b.Compare(a) != -1 || !a.Equals(NewFraction(7, 3).ToMixedNumber()) {
+		t.Errorf("MixedNumber comparison failed for %v and %v", a, b)
+	}
+}
+
+func TestParseMixedNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    *Fraction
+		wantErr bool
+	}{
+		{name: "ParseMixedNumber_Test01", s: "3 1/2", want: NewFraction(7, 2)},
+		{name: "ParseMixedNumber_Test02", s: "-3 1/2", want: NewFraction(-7, 2)},
+		{name: "ParseMixedNumber_Test03", s: "  7/2 ", want: NewFraction(7, 2)},
+		{name: "ParseMixedNumber_Test04", s: "5", want: NewInteger(5)},
+		{name: "ParseMixedNumber_Test05", s: "-0 1/4", want: NewFraction(-1, 4)},
+		{name: "ParseMixedNumber_BadFraction", s: "3 1/0", wantErr: true},
+		{name: "ParseMixedNumber_NegativeRemainder", s: "3 -1/2", wantErr: true},
+		{name: "ParseMixedNumber_TooManyParts", s: "1 2 3/4", wantErr: true},
+		{name: "ParseMixedNumber_Empty", s: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMixedNumber(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseMixedNumber() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !got.ToImproperFraction().Equals(tt.want) {
+				t.Errorf("ParseMixedNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,380 @@
+package basicmath
+
+import (
+	"fmt"
+	"math/big"
+	"mymath/latex"
+	"strconv"
+	"strings"
+)
+
+// EuclideanRow is one division of the extended Euclidean algorithm:
+// Dividend = Quotient*Divisor + Remainder, where Remainder = S*a + T*b for the original a and b.
+type EuclideanRow struct {
+	Dividend  int
+	Divisor   int
+	Quotient  int
+	Remainder int
+	S         int
+	T         int
+}
+
+// EuclideanTable holds every division performed while computing gcd(a, b)
+type EuclideanTable struct {
+	A    int
+	B    int
+	Rows []EuclideanRow
+}
+
+// DiophantineSolution describes every integer solution of ax + by = c as
+// x = X0 + DX*t and y = Y0 + DY*t for any integer t.
+type DiophantineSolution struct {
+	X0 int
+	Y0 int
+	DX int
+	DY int
+}
+
+// #region Public Methods
+
+// EuclideanAlgorithm runs the extended Euclidean algorithm on |a| and |b| and records each division
+func EuclideanAlgorithm(a, b int) *EuclideanTable {
+	table := &EuclideanTable{A: a, B: b}
+
+	oldR, r := Abs(a), Abs(b)
+	oldS, s := 1, 0
+	oldT, t := 0, 1
+
+	for r != 0 {
+		q := oldR / r
+		row := EuclideanRow{
+			Dividend:  oldR,
+			Divisor:   r,
+			Quotient:  q,
+			Remainder: oldR - q*r,
+			S:         oldS - q*s,
+			T:         oldT - q*t,
+		}
+		table.Rows = append(table.Rows, row)
+
+		oldR, r = r, row.Remainder
+		oldS, s = s, row.S
+		oldT, t = t, row.T
+	}
+
+	return table
+}
+
+// ExtendedGCD returns gcd(a, b) and Bézout coefficients x and y with ax + by = gcd(a, b)
+func ExtendedGCD(a, b int) (gcd, x, y int) {
+	return extendedGCD(a, b, nil)
+}
+
+// ExtendedGCDWithSteps is ExtendedGCD with the divisions and Bézout identity written out
+func ExtendedGCDWithSteps(a, b int) (gcd, x, y int, steps *StepLog) {
+	steps = &StepLog{}
+	gcd, x, y = extendedGCD(a, b, steps)
+	return gcd, x, y, steps
+}
+
+// ModPow returns base^exponent mod modulus in [0, modulus); negative exponents use the modular inverse
+func ModPow(base, exponent, modulus int) (int, error) {
+	return modPow(base, exponent, modulus, nil)
+}
+
+// ModPowWithSteps is ModPow with the repeated-squaring work written out
+func ModPowWithSteps(base, exponent, modulus int) (int, *StepLog, error) {
+	steps := &StepLog{}
+	result, err := modPow(base, exponent, modulus, steps)
+	return result, steps, err
+}
+
+// ModInverse returns x in [0, m) with ax ≡ 1 (mod m), or ErrNoInverse when gcd(a, m) != 1
+func ModInverse(a, m int) (int, error) {
+	return modInverse(a, m, nil)
+}
+
+// ModInverseWithSteps is ModInverse with the Euclidean algorithm written out
+func ModInverseWithSteps(a, m int) (int, *StepLog, error) {
+	steps := &StepLog{}
+	inverse, err := modInverse(a, m, steps)
+	return inverse, steps, err
+}
+
+// ChineseRemainder solves x ≡ residues[i] (mod moduli[i]) for every i, returning the smallest
+// non-negative x and the combined modulus. Moduli need not be coprime; ErrNoSolution is returned
+// when the congruences conflict.
+func ChineseRemainder(residues []int, moduli []int) (x int, modulus int, err error) {
+	return chineseRemainder(residues, moduli, nil)
+}
+
+// ChineseRemainderWithSteps is ChineseRemainder with each pairwise merge written out
+func ChineseRemainderWithSteps(residues []int, moduli []int) (x int, modulus int, steps *StepLog, err error) {
+	steps = &StepLog{}
+	x, modulus, err = chineseRemainder(residues, moduli, steps)
+	return x, modulus, steps, err
+}
+
+// SolveLinearDiophantine finds every integer solution of ax + by = c.
+// The particular solution uses the smallest non-negative x.
+func SolveLinearDiophantine(a, b, c int) (*DiophantineSolution, error) {
+	return solveLinearDiophantine(a, b, c, nil)
+}
+
+// SolveLinearDiophantineWithSteps is SolveLinearDiophantine with the work written out
+func SolveLinearDiophantineWithSteps(a, b, c int) (*DiophantineSolution, *StepLog, error) {
+	steps := &StepLog{}
+	solution, err := solveLinearDiophantine(a, b, c, steps)
+	return solution, steps, err
+}
+
+// GCD returns the greatest common divisor found by the table
+func (t *EuclideanTable) GCD() int {
+	if len(t.Rows) == 0 {
+		return Abs(t.A)
+	}
+	return t.Rows[len(t.Rows)-1].Divisor
+}
+
+// LaTeX renders the table with one division per row and the coefficients s and t
+// such that each remainder equals s*a + t*b
+func (t *EuclideanTable) LaTeX() string {
+	rows := [][]string{
+		{latex.Text("Division"), "s", "t"},
+		{`\hline`},
+		{fmt.Sprintf("%d", Abs(t.A)), "1", "0"},
+		{fmt.Sprintf("%d", Abs(t.B)), "0", "1"},
+	}
+
+	for _, row := range t.Rows {
+		rows = append(rows, []string{
+			fmt.Sprintf("$%d = %d \\cdot %d + %d$", row.Dividend, row.Quotient, row.Divisor, row.Remainder),
+			strconv.Itoa(row.S),
+			strconv.Itoa(row.T),
+		})
+	}
+
+	return latex.Tabular("r|rr", rows...)
+}
+
+func (s *DiophantineSolution) LaTeX() string {
+	return fmt.Sprintf(`x = %s,\quad y = %s,\quad t \in \mathbb{Z}`, linearInT(s.X0, s.DX), linearInT(s.Y0, s.DY))
+}
+
+func (s *DiophantineSolution) String() string {
+	return fmt.Sprintf("x = %s, y = %s", linearInT(s.X0, s.DX), linearInT(s.Y0, s.DY))
+}
+
+// #endregion
+
+// #region Private Methods
+
+func extendedGCD(a, b int, steps *StepLog) (gcd, x, y int) {
+	table := EuclideanAlgorithm(a, b)
+	gcd = table.GCD()
+
+	// coefficients for |a| and |b| come from the last two rows
+	x, y = 1, 0
+	if len(table.Rows) >= 2 {
+		previous := table.Rows[len(table.Rows)-2]
+		x, y = previous.S, previous.T
+	} else if len(table.Rows) == 1 {
+		x, y = 0, 1
+	}
+
+	if a < 0 {
+		x = -x
+	}
+	if b < 0 {
+		y = -y
+	}
+
+	for _, row := range table.Rows {
+		steps.Add("divide", fmt.Sprintf(`%d = %d \cdot %d + %d`, row.Dividend, row.Quotient, row.Divisor, row.Remainder))
+	}
+	steps.Add("last non-zero remainder", fmt.Sprintf(`\gcd(%d, %d) = %d`, a, b, gcd))
+	steps.Add("Bézout coefficients", fmt.Sprintf(`%d = %s`, gcd, latex.ConnectWithPlusSign(
+		fmt.Sprintf(`%d \cdot %s`, x, parenthesizeNegative(a)),
+		fmt.Sprintf(`%d \cdot %s`, y, parenthesizeNegative(b)))))
+
+	return gcd, x, y
+}
+
+func modPow(base, exponent, modulus int, steps *StepLog) (int, error) {
+	if modulus <= 0 {
+		return 0, ErrInvalidModulus
+	}
+
+	if exponent < 0 {
+		inverse, err := modInverse(base, modulus, steps)
+		if err != nil {
+			return 0, err
+		}
+		steps.Add("use the inverse for a negative exponent", fmt.Sprintf(`%d^{%d} \equiv %d^{%d} \pmod{%d}`, base, exponent, inverse, -exponent, modulus))
+		base, exponent = inverse, -exponent
+	}
+
+	reduced := ((base % modulus) + modulus) % modulus
+	m := uint64(modulus)
+
+	if exponent == 0 {
+		result := int(1 % m)
+		steps.Add("any number to the power 0", fmt.Sprintf(`%d^{0} \equiv %d \pmod{%d}`, base, result, modulus))
+		return result, nil
+	}
+
+	steps.Add("write the exponent in binary", fmt.Sprintf(`%d = %s_{2}`, exponent, strconv.FormatInt(int64(exponent), 2)))
+
+	power := uint64(reduced)
+	result := uint64(1) % m
+	used := []string{}
+
+	for bit, e := 0, exponent; e > 0; bit, e = bit+1, e>>1 {
+		if bit == 0 {
+			steps.Add("reduce the base", fmt.Sprintf(`%d^{1} \equiv %d \pmod{%d}`, base, power, modulus))
+		} else {
+			power = mulMod64(power, power, m)
+			steps.Add("square the previous power", fmt.Sprintf(`%d^{%d} \equiv %d \pmod{%d}`, base, 1<<bit, power, modulus))
+		}
+
+		if e&1 == 1 {
+			result = mulMod64(result, power, m)
+			used = append([]string{fmt.Sprintf(`%d^{%d}`, base, 1<<bit)}, used...)
+		}
+	}
+
+	steps.Add("multiply the powers for each 1 bit", fmt.Sprintf(`%d^{%d} \equiv %s \equiv %d \pmod{%d}`, base, exponent, strings.Join(used, ` \cdot `), result, modulus))
+
+	return int(result), nil
+}
+
+func modInverse(a, m int, steps *StepLog) (int, error) {
+	if m <= 0 {
+		return 0, ErrInvalidModulus
+	}
+
+	reduced := ((a % m) + m) % m
+	gcd, x, _ := extendedGCD(reduced, m, steps)
+	if gcd != 1 {
+		steps.Add("no inverse exists", fmt.Sprintf(`\gcd(%d, %d) = %d \neq 1`, a, m, gcd))
+		return 0, ErrNoInverse
+	}
+
+	inverse := ((x % m) + m) % m
+	steps.Add("the coefficient of a is the inverse", fmt.Sprintf(`%d^{-1} \equiv %d \pmod{%d}`, a, inverse, m))
+
+	return inverse, nil
+}
+
+func chineseRemainder(residues []int, moduli []int, steps *StepLog) (int, int, error) {
+	if len(residues) != len(moduli) || len(residues) == 0 {
+		return 0, 0, fmt.Errorf("basicmath: need the same, non-zero number of residues and moduli, got %d and %d", len(residues), len(moduli))
+	}
+
+	for _, m := range moduli {
+		if m <= 0 {
+			return 0, 0, ErrInvalidModulus
+		}
+	}
+
+	x := new(big.Int).Mod(big.NewInt(int64(residues[0])), big.NewInt(int64(moduli[0])))
+	modulus := big.NewInt(int64(moduli[0]))
+
+	for i := 1; i < len(residues); i++ {
+		m2 := big.NewInt(int64(moduli[i]))
+		a2 := new(big.Int).Mod(big.NewInt(int64(residues[i])), m2)
+
+		steps.Add("combine two congruences", fmt.Sprintf(`x \equiv %s \pmod{%s},\ x \equiv %s \pmod{%s}`, x, modulus, a2, m2))
+
+		g := new(big.Int).GCD(nil, nil, modulus, m2)
+		diff := new(big.Int).Sub(a2, x)
+		if new(big.Int).Mod(diff, g).Sign() != 0 {
+			steps.Add("the congruences conflict", fmt.Sprintf(`%s \not\equiv %s \pmod{%s}`, x, a2, g))
+			return 0, 0, ErrNoSolution
+		}
+
+		// x + modulus*k ≡ a2 (mod m2)  =>  k ≡ (diff/g) * (modulus/g)^-1 (mod m2/g)
+		reducedModulus := new(big.Int).Quo(m2, g)
+		inverse := new(big.Int).ModInverse(new(big.Int).Quo(modulus, g), reducedModulus)
+		if inverse == nil {
+			inverse = big.NewInt(0) // reducedModulus is 1, so any k works
+		}
+
+		k := new(big.Int).Mul(new(big.Int).Quo(diff, g), inverse)
+		k.Mod(k, reducedModulus)
+
+		x.Add(x, new(big.Int).Mul(modulus, k))
+		modulus.Mul(modulus, reducedModulus)
+		x.Mod(x, modulus)
+
+		steps.Add("solve for the combined residue", fmt.Sprintf(`x \equiv %s \pmod{%s}`, x, modulus))
+	}
+
+	if !x.IsInt64() || !modulus.IsInt64() || !fitsInInt(modulus.Int64()) {
+		return 0, 0, ErrOverflow
+	}
+
+	return int(x.Int64()), int(modulus.Int64()), nil
+}
+
+func solveLinearDiophantine(a, b, c int, steps *StepLog) (*DiophantineSolution, error) {
+	if a == 0 && b == 0 {
+		return nil, fmt.Errorf("basicmath: a and b cannot both be zero")
+	}
+
+	gcd, x, y := extendedGCD(a, b, steps)
+	if c%gcd != 0 {
+		steps.Add("no solution since the gcd does not divide c", fmt.Sprintf(`%d \nmid %d`, gcd, c))
+		return nil, ErrNoSolution
+	}
+
+	k := c / gcd
+	x0, xOk := multiplyInts(x, k)
+	y0, yOk := multiplyInts(y, k)
+	if !xOk || !yOk {
+		return nil, ErrOverflow
+	}
+	steps.Add(fmt.Sprintf("multiply the Bézout identity by %d", k), fmt.Sprintf(`x_0 = %d,\ y_0 = %d`, x0, y0))
+
+	solution := &DiophantineSolution{X0: x0, Y0: y0, DX: b / gcd, DY: -a / gcd}
+
+	// shift to the smallest non-negative x
+	if solution.DX != 0 {
+		m := Abs(solution.DX)
+		r := ((x0 % m) + m) % m
+		t := (r - x0) / solution.DX
+
+		shift, ok := multiplyInts(solution.DY, t)
+		y1, sumOk := addInts(y0, shift)
+		if !ok || !sumOk {
+			return nil, ErrOverflow
+		}
+		solution.X0, solution.Y0 = r, y1
+	}
+
+	steps.Add("general solution", solution.LaTeX())
+
+	return solution, nil
+}
+
+func linearInT(constant, coefficient int) string {
+	switch coefficient {
+	case 0:
+		return strconv.Itoa(constant)
+	case 1:
+		return latex.ConnectWithPlusSign(strconv.Itoa(constant), "t")
+	case -1:
+		return latex.ConnectWithPlusSign(strconv.Itoa(constant), "-t")
+	}
+
+	return latex.ConnectWithPlusSign(strconv.Itoa(constant), fmt.Sprintf("%dt", coefficient))
+}
+
+func parenthesizeNegative(value int) string {
+	if value < 0 {
+		return fmt.Sprintf("(%d)", value)
+	}
+	return strconv.Itoa(value)
+}
+
+// #endregion
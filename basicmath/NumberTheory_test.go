@@ -0,0 +1,225 @@
+package basicmath
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExtendedGCD(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    int
+		wantGCD int
+	}{
+		{name: "NumberTheory_ExtendedGCD_Basic", a: 252, b: 105, wantGCD: 21},
+		{name: "NumberTheory_ExtendedGCD_Swapped", a: 105, b: 252, wantGCD: 21},
+		{name: "NumberTheory_ExtendedGCD_Coprime", a: 240, b: 46, wantGCD: 2},
+		{name: "NumberTheory_ExtendedGCD_Negative", a: -30, b: 12, wantGCD: 6},
+		{name: "NumberTheory_ExtendedGCD_BothNegative", a: -30, b: -42, wantGCD: 6},
+		{name: "NumberTheory_ExtendedGCD_ZeroB", a: 7, b: 0, wantGCD: 7},
+		{name: "NumberTheory_ExtendedGCD_ZeroA", a: 0, b: -9, wantGCD: 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gcd, x, y := ExtendedGCD(tt.a, tt.b)
+			if gcd != tt.wantGCD {
+				t.Errorf("ExtendedGCD(%d, %d) gcd = %d, want %d", tt.a, tt.b, gcd, tt.wantGCD)
+			}
+			if tt.a*x+tt.b*y != gcd {
+				t.Errorf("ExtendedGCD(%d, %d) = %d, %d; %d*%d + %d*%d != %d", tt.a, tt.b, x, y, tt.a, x, tt.b, y, gcd)
+			}
+		})
+	}
+}
+
+func TestExtendedGCDWithSteps(t *testing.T) {
+	_, _, _, steps := ExtendedGCDWithSteps(252, 105)
+
+	want := []string{
+		`252 = 2 \cdot 105 + 42`,
+		`105 = 2 \cdot 42 + 21`,
+		`42 = 2 \cdot 21 + 0`,
+		`\gcd(252, 105) = 21`,
+		`21 = -2 \cdot 252 + 5 \cdot 105`,
+	}
+
+	got := steps.Steps()
+	if len(got) != len(want) {
+		t.Fatalf("ExtendedGCDWithSteps() recorded %d steps, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Expression != want[i] {
+			t.Errorf("step %d = %q, want %q", i, got[i].Expression, want[i])
+		}
+	}
+}
+
+func TestEuclideanTable_LaTeX(t *testing.T) {
+	want := "\\begin{tabular}{r|rr}\n" +
+		"\\text{Division} & s & t \\\\\n" +
+		"\\hline\n" +
+		"252 & 1 & 0 \\\\\n" +
+		"105 & 0 & 1 \\\\\n" +
+		"$252 = 2 \\cdot 105 + 42$ & 1 & -2 \\\\\n" +
+		"$105 = 2 \\cdot 42 + 21$ & -2 & 5 \\\\\n" +
+		"$42 = 2 \\cdot 21 + 0$ & 5 & -12 \\\\\n" +
+		"\\end{tabular}"
+
+	if got := EuclideanAlgorithm(252, 105).LaTeX(); got != want {
+		t.Errorf("EuclideanTable.LaTeX() = %q, want %q", got, want)
+	}
+}
+
+func TestModPow(t *testing.T) {
+	tests := []struct {
+		name                    string
+		base, exponent, modulus int
+		want                    int
+		wantErr                 error
+	}{
+		{name: "NumberTheory_ModPow_Basic", base: 3, exponent: 13, modulus: 7, want: 3},
+		{name: "NumberTheory_ModPow_ZeroExponent", base: 5, exponent: 0, modulus: 7, want: 1},
+		{name: "NumberTheory_ModPow_ModulusOne", base: 5, exponent: 3, modulus: 1, want: 0},
+		{name: "NumberTheory_ModPow_NegativeBase", base: -2, exponent: 3, modulus: 5, want: 2},
+		{name: "NumberTheory_ModPow_NegativeExponent", base: 3, exponent: -1, modulus: 7, want: 5},
+		{name: "NumberTheory_ModPow_Large", base: 2, exponent: 1000000, modulus: 1000000007, want: 235042059},
+		{name: "NumberTheory_ModPow_LargeModulus", base: 123456789, exponent: 2, modulus: 2305843009213693951, want: 15241578750190521},
+		{name: "NumberTheory_ModPow_NoInverse", base: 2, exponent: -1, modulus: 4, wantErr: ErrNoInverse},
+		{name: "NumberTheory_ModPow_InvalidModulus", base: 2, exponent: 3, modulus: 0, wantErr: ErrInvalidModulus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ModPow(tt.base, tt.exponent, tt.modulus)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ModPow() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ModPow(%d, %d, %d) = %d, want %d", tt.base, tt.exponent, tt.modulus, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModPowWithSteps(t *testing.T) {
+	_, steps, err := ModPowWithSteps(3, 13, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := steps.Steps()
+	if got[0].Expression != `13 = 1101_{2}` {
+		t.Errorf("first step = %q", got[0].Expression)
+	}
+
+	want := `3^{13} \equiv 3^{8} \cdot 3^{4} \cdot 3^{1} \equiv 3 \pmod{7}`
+	if last := got[len(got)-1].Expression; last != want {
+		t.Errorf("last step = %q, want %q", last, want)
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, m    int
+		want    int
+		wantErr error
+	}{
+		{name: "NumberTheory_ModInverse_Basic", a: 3, m: 11, want: 4},
+		{name: "NumberTheory_ModInverse_Negative", a: -3, m: 11, want: 7},
+		{name: "NumberTheory_ModInverse_LargerThanModulus", a: 17, m: 5, want: 3},
+		{name: "NumberTheory_ModInverse_NotCoprime", a: 6, m: 9, wantErr: ErrNoInverse},
+		{name: "NumberTheory_ModInverse_InvalidModulus", a: 3, m: -5, wantErr: ErrInvalidModulus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ModInverse(tt.a, tt.m)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ModInverse() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ModInverse(%d, %d) = %d, want %d", tt.a, tt.m, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChineseRemainder(t *testing.T) {
+	tests := []struct {
+		name        string
+		residues    []int
+		moduli      []int
+		wantX       int
+		wantModulus int
+		wantErr     error
+	}{
+		{name: "NumberTheory_ChineseRemainder_Classic", residues: []int{2, 3, 2}, moduli: []int{3, 5, 7}, wantX: 23, wantModulus: 105},
+		{name: "NumberTheory_ChineseRemainder_Single", residues: []int{-1}, moduli: []int{4}, wantX: 3, wantModulus: 4},
+		{name: "NumberTheory_ChineseRemainder_NotCoprime", residues: []int{3, 5}, moduli: []int{4, 6}, wantX: 11, wantModulus: 12},
+		{name: "NumberTheory_ChineseRemainder_Conflict", residues: []int{1, 2}, moduli: []int{4, 6}, wantErr: ErrNoSolution},
+		{name: "NumberTheory_ChineseRemainder_InvalidModulus", residues: []int{1}, moduli: []int{0}, wantErr: ErrInvalidModulus},
+		{name: "NumberTheory_ChineseRemainder_Overflow", residues: []int{1, 1, 1}, moduli: []int{1000000007, 1000000009, 998244353}, wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, modulus, err := ChineseRemainder(tt.residues, tt.moduli)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ChineseRemainder() error = %v, want %v", err, tt.wantErr)
+			}
+			if x != tt.wantX || modulus != tt.wantModulus {
+				t.Errorf("ChineseRemainder() = %d (mod %d), want %d (mod %d)", x, modulus, tt.wantX, tt.wantModulus)
+			}
+		})
+	}
+
+	if _, _, err := ChineseRemainder([]int{1, 2}, []int{3}); err == nil {
+		t.Error("ChineseRemainder() with mismatched lengths should fail")
+	}
+}
+
+func TestSolveLinearDiophantine(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b, c int
+		want    *DiophantineSolution
+		wantErr error
+	}{
+		{name: "NumberTheory_Diophantine_Basic", a: 3, b: 5, c: 1, want: &DiophantineSolution{X0: 2, Y0: -1, DX: 5, DY: -3}},
+		{name: "NumberTheory_Diophantine_Scaled", a: 252, b: 105, c: 63, want: &DiophantineSolution{X0: 4, Y0: -9, DX: 5, DY: -12}},
+		{name: "NumberTheory_Diophantine_NegativeB", a: 4, b: -6, c: 2, want: &DiophantineSolution{X0: 2, Y0: 1, DX: -3, DY: -2}},
+		{name: "NumberTheory_Diophantine_ZeroB", a: 4, b: 0, c: 8, want: &DiophantineSolution{X0: 2, Y0: 0, DX: 0, DY: -1}},
+		{name: "NumberTheory_Diophantine_NoSolution", a: 4, b: 6, c: 3, wantErr: ErrNoSolution},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SolveLinearDiophantine(tt.a, tt.b, tt.c)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SolveLinearDiophantine() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want == nil {
+				return
+			}
+			if *got != *tt.want {
+				t.Errorf("SolveLinearDiophantine(%d, %d, %d) = %+v, want %+v", tt.a, tt.b, tt.c, got, tt.want)
+			}
+			if tt.a*got.X0+tt.b*got.Y0 != tt.c {
+				t.Errorf("particular solution (%d, %d) does not satisfy the equation", got.X0, got.Y0)
+			}
+		})
+	}
+
+	if _, err := SolveLinearDiophantine(0, 0, 1); err == nil {
+		t.Error("SolveLinearDiophantine(0, 0, 1) should fail")
+	}
+}
+
+func TestDiophantineSolution_String(t *testing.T) {
+	solution := &DiophantineSolution{X0: 2, Y0: -1, DX: 5, DY: -3}
+
+	if got := solution.String(); got != "x = 2 + 5t, y = -1 - 3t" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := solution.LaTeX(); !strings.HasPrefix(got, `x = 2 + 5t,\quad y = -1 - 3t`) {
+		t.Errorf("LaTeX() = %q", got)
+	}
+}
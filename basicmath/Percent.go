@@ -0,0 +1,165 @@
+package basicmath
+
+import (
+	"fmt"
+	"strings"
+)
+
+// #region Constructor
+
+// Percent is an exact percentage; its value is the number before the percent sign, so 12.5% has value 25/2
+type Percent struct {
+	value *Fraction
+}
+
+// NewPercent builds value%, e.g. NewPercent(NewInteger(15)) is 15%
+func NewPercent(value *Fraction) *Percent {
+	return &Percent{value: value.simplifiedCopy()}
+}
+
+// ParsePercent reads text such as "15%", "12.5 %", "-3%" or "33 1/3%"; the percent sign is optional
+func ParsePercent(s string) (*Percent, error) {
+	text := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "%"))
+
+	if strings.Contains(text, " ") {
+		if mixed, err := ParseMixedNumber(text); err == nil {
+			return NewPercent(mixed.ToImproperFraction()), nil
+		}
+	}
+
+	value, err := ParseFraction(text)
+	if err != nil {
+		return nil, fmt.Errorf("basicmath: invalid percent %q", s)
+	}
+
+	return NewPercent(value), nil
+}
+
+// ToPercent writes the fraction as a percentage, e.g. 3/8 is 37.5%.
+// It panics with ErrOverflow if the value times 100 does not fit in an int.
+func (f *Fraction) ToPercent() *Percent {
+	return NewPercent(f.Multiply(NewInteger(100)))
+}
+
+// #endregion
+
+// #region Properties
+
+// Value is the number before the percent sign, e.g. 25/2 for 12.5%
+func (p *Percent) Value() *Fraction {
+	return p.value.simplifiedCopy()
+}
+
+// #endregion
+
+// #region Comparable
+
+func (p *Percent) Compare(other *Percent) int {
+	return p.value.Compare(other.value)
+}
+
+func (p *Percent) Equals(other *Percent) bool {
+	return p.Compare(other) == 0
+}
+
+func (p *Percent) GreaterThan(other *Percent) bool {
+	return p.Compare(other) > 0
+}
+
+func (p *Percent) GreaterThanOrEqualTo(other *Percent) bool {
+	return p.Compare(other) >= 0
+}
+
+func (p *Percent) LessThan(other *Percent) bool {
+	return p.Compare(other) < 0
+}
+
+func (p *Percent) LessThanOrEqualTo(other *Percent) bool {
+	return p.Compare(other) <= 0
+}
+
+// #endregion
+
+// #region LaTeXer
+
+// LaTeX renders the percentage with an escaped percent sign, e.g. 12.5\% or 33.\overline{3}\%
+func (p *Percent) LaTeX() string {
+	if decimal, err := p.value.ToRepeatingDecimal(); err == nil {
+		return decimal.LaTeX() + `\%`
+	}
+	return p.value.LaTeX() + `\%`
+}
+
+// #endregion
+
+// #region Stringer
+
+// String writes the percentage as a decimal, e.g. 12.5% or 33.(3)% for one third
+func (p *Percent) String() string {
+	if decimal, err := p.value.ToRepeatingDecimal(); err == nil {
+		return decimal.String() + "%"
+	}
+	return p.value.String() + "%"
+}
+
+// #endregion
+
+// #region Public Methods
+
+// PercentChange is the change from original to updated as a percentage of original,
+// e.g. 80 to 100 is 25% and 100 to 80 is -20%
+func PercentChange(original *Fraction, updated *Fraction) (*Percent, error) {
+	if original.n == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	change, err := updated.TrySubtract(original)
+	if err != nil {
+		return nil, err
+	}
+	ratio, err := change.TryDivide(original)
+	if err != nil {
+		return nil, err
+	}
+	value, err := ratio.TryMultiply(NewInteger(100))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPercent(value), nil
+}
+
+// Markup raises cost by the percentage, e.g. a 40% markup on 25 is 35
+func Markup(cost *Fraction, markup *Percent) (*Fraction, error) {
+	factor, err := NewInteger(1).TryAdd(markup.ToFraction())
+	if err != nil {
+		return nil, err
+	}
+	return cost.TryMultiply(factor)
+}
+
+// Discount lowers price by the percentage, e.g. 20% off 35 is 28
+func Discount(price *Fraction, discount *Percent) (*Fraction, error) {
+	factor, err := NewInteger(1).TrySubtract(discount.ToFraction())
+	if err != nil {
+		return nil, err
+	}
+	return price.TryMultiply(factor)
+}
+
+// Of returns the percentage of amount, e.g. 15% of 80 is 12
+func (p *Percent) Of(amount *Fraction) (*Fraction, error) {
+	return amount.TryMultiply(p.ToFraction())
+}
+
+// ToDecimal writes the rate as a decimal rounded to places decimal places, e.g. 12.5% is 0.125
+func (p *Percent) ToDecimal(places int, mode RoundingMode) (*Decimal, error) {
+	return p.ToFraction().ToDecimal(places, mode)
+}
+
+// ToFraction returns the rate the percentage stands for, e.g. 12.5% is 1/8
+func (p *Percent) ToFraction() *Fraction {
+	return p.value.Divide(NewInteger(100)).simplifiedCopy()
+}
+
+// #endregion
@@ -0,0 +1,110 @@
+package basicmath
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParsePercent(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      *Fraction
+		wantLaTeX string
+	}{
+		{"ParsePercent_Integer", "15%", NewInteger(15), `15\%`},
+		{"ParsePercent_Decimal", "12.5 %", NewFraction(25, 2), `12.5\%`},
+		{"ParsePercent_Negative", "-3%", NewInteger(-3), `-3\%`},
+		{"ParsePercent_MixedNumber", "33 1/3%", NewFraction(100, 3), `33.\overline{3}\%`},
+		{"ParsePercent_NoSign", "40", NewInteger(40), `40\%`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePercent(tt.input)
+			if err != nil {
+				t.Fatalf("ParsePercent() error = %v", err)
+			}
+			if !got.Value().Equals(tt.want) {
+				t.Errorf("ParsePercent() = %v, want %v%%", got, tt.want)
+			}
+			if got.LaTeX() != tt.wantLaTeX {
+				t.Errorf("LaTeX() = %v, want %v", got.LaTeX(), tt.wantLaTeX)
+			}
+		})
+	}
+
+	if _, err := ParsePercent("abc%"); err == nil {
+		t.Errorf("ParsePercent() should fail for abc%%")
+	}
+}
+
+func TestPercent_Conversions(t *testing.T) {
+	if got := NewFraction(3, 8).ToPercent(); got.String() != "37.5%" {
+		t.Errorf("ToPercent() = %v, want 37.5%%", got)
+	}
+	if got := NewPercent(NewFraction(25, 2)).ToFraction(); !got.Equals(NewFraction(1, 8)) {
+		t.Errorf("ToFraction() = %v, want 1/8", got)
+	}
+	if got, _ := NewPercent(NewInteger(15)).Of(NewInteger(80)); !got.Equals(NewInteger(12)) {
+		t.Errorf("Of() = %v, want 12", got)
+	}
+	if got, _ := NewPercent(NewFraction(25, 2)).ToDecimal(3, RoundHalfUp); got.String() != "0.125" {
+		t.Errorf("ToDecimal() = %v, want 0.125", got)
+	}
+}
+
+func TestPercentChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Fraction
+		updated  *Fraction
+		want     string
+	}{
+		{"PercentChange_Increase", NewInteger(80), NewInteger(100), "25%"},
+		{"PercentChange_Decrease", NewInteger(100), NewInteger(80), "-20%"},
+		{"PercentChange_Repeating", NewInteger(3), NewInteger(2), "-33.(3)%"},
+		{"PercentChange_NoChange", NewFraction(5, 2), NewFraction(5, 2), "0%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PercentChange(tt.original, tt.updated)
+			if err != nil {
+				t.Fatalf("PercentChange() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("PercentChange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := PercentChange(NewInteger(0), NewInteger(5)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("PercentChange() error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestMarkupAndDiscount(t *testing.T) {
+	price, err := Markup(NewInteger(25), NewPercent(NewInteger(40)))
+	if err != nil || !price.Equals(NewInteger(35)) {
+		t.Errorf("Markup() = %v, %v, want 35", price, err)
+	}
+
+	sale, err := Discount(price, NewPercent(NewInteger(20)))
+	if err != nil || !sale.Equals(NewInteger(28)) {
+		t.Errorf("Discount() = %v, %v, want 28", sale, err)
+	}
+
+	// 15% off 19.99 is 16.9915, which rounds to 16.99
+	original, _ := ParseFraction("19.99")
+	discounted, _ := Discount(original, NewPercent(NewInteger(15)))
+	if got, _ := discounted.ToDecimal(2, RoundHalfUp); got.String() != "16.99" {
+		t.Errorf("Discount() rounded = %v, want 16.99", got)
+	}
+
+	if _, err := Markup(NewInteger(1), NewPercent(NewInteger(math.MaxInt))); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Markup() error = %v, want %v", err, ErrOverflow)
+	}
+	if _, err := Discount(NewInteger(1), NewPercent(NewInteger(-math.MaxInt))); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Discount() error = %v, want %v", err, ErrOverflow)
+	}
+}
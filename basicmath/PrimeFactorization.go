@@ -0,0 +1,214 @@
+package basicmath
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PrimeFactor is a prime together with how many times it divides a number
+type PrimeFactor struct {
+	Prime    int
+	Exponent int
+}
+
+// #region Constructor
+
+// PrimeFactorization is the ordered factorization of an integer, smallest prime first.
+// Negative values carry a leading factor of -1 in their output; 0 and 1 have no prime factors.
+type PrimeFactorization struct {
+	value   int
+	factors []PrimeFactor
+}
+
+func Factorize(n int) *PrimeFactorization {
+	pf := &PrimeFactorization{value: n}
+
+	for _, prime := range PrimeFactors(n) {
+		last := len(pf.factors) - 1
+		if last >= 0 && pf.factors[last].Prime == prime {
+			pf.factors[last].Exponent++
+		} else {
+			pf.factors = append(pf.factors, PrimeFactor{Prime: prime, Exponent: 1})
+		}
+	}
+
+	return pf
+}
+
+// #endregion
+
+// #region Properties
+
+// Factors returns the prime/exponent pairs in ascending order of prime
+func (pf *PrimeFactorization) Factors() []PrimeFactor {
+	factors := make([]PrimeFactor, len(pf.factors))
+	copy(factors, pf.factors)
+	return factors
+}
+
+// Value returns the number that was factored
+func (pf *PrimeFactorization) Value() int {
+	return pf.value
+}
+
+// #endregion
+
+// #region LaTeXer
+
+// LaTeX renders the exponent form, e.g. 2^{2}\cdot 3
+func (pf *PrimeFactorization) LaTeX() string {
+	return pf.join(`\cdot `, func(factor PrimeFactor) string {
+		if factor.Exponent == 1 {
+			return fmt.Sprintf("%d", factor.Prime)
+		}
+		return fmt.Sprintf("%d^{%d}", factor.Prime, factor.Exponent)
+	})
+}
+
+// #endregion
+
+// #region Stringer
+
+// String lists every prime factor in ascending order, e.g. 2 * 2 * 3
+func (pf *PrimeFactorization) String() string {
+	parts := pf.signParts()
+	for _, factor := range pf.factors {
+		for i := 0; i < factor.Exponent; i++ {
+			parts = append(parts, fmt.Sprintf("%d", factor.Prime))
+		}
+	}
+
+	if len(parts) == 0 {
+		return fmt.Sprintf("%d", pf.value)
+	}
+
+	return strings.Join(parts, " * ")
+}
+
+// #endregion
+
+// #region Public Methods
+
+// ExponentForm renders the factorization with exponents, e.g. 2^2 · 3
+func (pf *PrimeFactorization) ExponentForm() string {
+	return pf.join(" · ", func(factor PrimeFactor) string {
+		if factor.Exponent == 1 {
+			return fmt.Sprintf("%d", factor.Prime)
+		}
+		return fmt.Sprintf("%d^%d", factor.Prime, factor.Exponent)
+	})
+}
+
+// FactorTree draws the factor tree, splitting off the smallest prime at each level:
+//
+//	60
+//	├── 2
+//	└── 30
+//	    ├── 2
+//	    └── 15
+//	        ├── 3
+//	        └── 5
+func (pf *PrimeFactorization) FactorTree() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("%d\n", pf.value))
+	for _, branch := range pf.branches() {
+		sb.WriteString(branch.indent)
+		sb.WriteString(fmt.Sprintf("├── %d\n", branch.leaf))
+		sb.WriteString(branch.indent)
+		sb.WriteString(fmt.Sprintf("└── %d\n", branch.rest))
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// FactorTreeLaTeX renders the factor tree for the LaTeX forest package
+func (pf *PrimeFactorization) FactorTreeLaTeX() string {
+	branches := pf.branches()
+
+	var sb strings.Builder
+	sb.WriteString(`\begin{forest}` + "\n")
+	sb.WriteString(fmt.Sprintf("[%d", pf.value))
+	for _, branch := range branches {
+		sb.WriteString(fmt.Sprintf(" [%d] [%d", branch.leaf, branch.rest))
+	}
+	sb.WriteString(strings.Repeat("]", len(branches)+1))
+	sb.WriteString("\n" + `\end{forest}`)
+
+	return sb.String()
+}
+
+// PrimeFactorizations returns the ordered factorizations of the numerator and denominator
+func (f *Fraction) PrimeFactorizations() (numerator *PrimeFactorization, denominator *PrimeFactorization) {
+	return Factorize(f.n), Factorize(f.d)
+}
+
+// FactorLaTeX renders the factored fraction, e.g. \dfrac{2^{2}\cdot 3}{5\cdot 7}
+func (f *Fraction) FactorLaTeX() string {
+	numerator, denominator := f.PrimeFactorizations()
+
+	if f.d == 1 {
+		return numerator.LaTeX()
+	}
+
+	return fmt.Sprintf(`\dfrac{%s}{%s}`, numerator.LaTeX(), denominator.LaTeX())
+}
+
+// #endregion
+
+// #region Private Methods
+
+type factorTreeBranch struct {
+	indent string
+	leaf   int
+	rest   int
+}
+
+// each level of the tree splits the remaining value into its smallest factor and the cofactor
+func (pf *PrimeFactorization) branches() []factorTreeBranch {
+	branches := []factorTreeBranch{}
+	indent := ""
+	remaining := pf.value
+
+	if remaining < -1 {
+		branches = append(branches, factorTreeBranch{indent: indent, leaf: -1, rest: -remaining})
+		indent += "    "
+		remaining = -remaining
+	}
+
+	for _, factor := range pf.factors {
+		for i := 0; i < factor.Exponent; i++ {
+			if remaining == factor.Prime {
+				return branches
+			}
+
+			remaining /= factor.Prime
+			branches = append(branches, factorTreeBranch{indent: indent, leaf: factor.Prime, rest: remaining})
+			indent += "    "
+		}
+	}
+
+	return branches
+}
+
+func (pf *PrimeFactorization) join(separator string, format func(PrimeFactor) string) string {
+	parts := pf.signParts()
+	for _, factor := range pf.factors {
+		parts = append(parts, format(factor))
+	}
+
+	if len(parts) == 0 {
+		return fmt.Sprintf("%d", pf.value)
+	}
+
+	return strings.Join(parts, separator)
+}
+
+func (pf *PrimeFactorization) signParts() []string {
+	if pf.value < 0 {
+		return []string{"-1"}
+	}
+	return []string{}
+}
+
+// #endregion
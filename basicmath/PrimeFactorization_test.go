@@ -0,0 +1,134 @@
+package basicmath
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFactorize(t *testing.T) {
+	tests := []struct {
+		name         string
+		n            int
+		wantFactors  []PrimeFactor
+		wantString   string
+		wantExponent string
+		wantLaTeX    string
+	}{
+		{
+			name:         "PrimeFactorization_Test01",
+			n:            12,
+			wantFactors:  []PrimeFactor{{Prime: 2, Exponent: 2}, {Prime: 3, Exponent: 1}},
+			wantString:   "2 * 2 * 3",
+			wantExponent: "2^2 · 3",
+			wantLaTeX:    `2^{2}\cdot 3`,
+		},
+		{
+			name:         "PrimeFactorization_Test02",
+			n:            -360,
+			wantFactors:  []PrimeFactor{{Prime: 2, Exponent: 3}, {Prime: 3, Exponent: 2}, {Prime: 5, Exponent: 1}},
+			wantString:   "-1 * 2 * 2 * 2 * 3 * 3 * 5",
+			wantExponent: "-1 · 2^3 · 3^2 · 5",
+			wantLaTeX:    `-1\cdot 2^{3}\cdot 3^{2}\cdot 5`,
+		},
+		{
+			name:         "PrimeFactorization_Prime",
+			n:            13,
+			wantFactors:  []PrimeFactor{{Prime: 13, Exponent: 1}},
+			wantString:   "13",
+			wantExponent: "13",
+			wantLaTeX:    "13",
+		},
+		{
+			name:         "PrimeFactorization_One",
+			n:            1,
+			wantFactors:  []PrimeFactor{},
+			wantString:   "1",
+			wantExponent: "1",
+			wantLaTeX:    "1",
+		},
+		{
+			name:         "PrimeFactorization_Zero",
+			n:            0,
+			wantFactors:  []PrimeFactor{},
+			wantString:   "0",
+			wantExponent: "0",
+			wantLaTeX:    "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Factorize(tt.n)
+			if !reflect.DeepEqual(got.Factors(), tt.wantFactors) {
+				t.Errorf("PrimeFactorization.Factors() = %v, want %v", got.Factors(), tt.wantFactors)
+			}
+			if got.String() != tt.wantString {
+				t.Errorf("PrimeFactorization.String() = %v, want %v", got.String(), tt.wantString)
+			}
+			if got.ExponentForm() != tt.wantExponent {
+				t.Errorf("PrimeFactorization.ExponentForm() = %v, want %v", got.ExponentForm(), tt.wantExponent)
+			}
+			if got.LaTeX() != tt.wantLaTeX {
+				t.Errorf("PrimeFactorization.LaTeX() = %v, want %v", got.LaTeX(), tt.wantLaTeX)
+			}
+		})
+	}
+}
+
+func TestPrimeFactorization_FactorTree(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		wantTree  string
+		wantLaTeX string
+	}{
+		{
+			name: "PrimeFactorization_FactorTree_Test01",
+			n:    60,
+			wantTree: "60\n" +
+				"├── 2\n" +
+				"└── 30\n" +
+				"    ├── 2\n" +
+				"    └── 15\n" +
+				"        ├── 3\n" +
+				"        └── 5",
+			wantLaTeX: "\\begin{forest}\n[60 [2] [30 [2] [15 [3] [5]]]]\n\\end{forest}",
+		},
+		{
+			name:      "PrimeFactorization_FactorTree_Prime",
+			n:         7,
+			wantTree:  "7",
+			wantLaTeX: "\\begin{forest}\n[7]\n\\end{forest}",
+		},
+		{
+			name: "PrimeFactorization_FactorTree_Negative",
+			n:    -6,
+			wantTree: "-6\n" +
+				"├── -1\n" +
+				"└── 6\n" +
+				"    ├── 2\n" +
+				"    └── 3",
+			wantLaTeX: "\\begin{forest}\n[-6 [-1] [6 [2] [3]]]\n\\end{forest}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Factorize(tt.n)
+			if got.FactorTree() != tt.wantTree {
+				t.Errorf("PrimeFactorization.FactorTree() = \n%v\nwant\n%v", got.FactorTree(), tt.wantTree)
+			}
+			if got.FactorTreeLaTeX() != tt.wantLaTeX {
+				t.Errorf("PrimeFactorization.FactorTreeLaTeX() = %v, want %v", got.FactorTreeLaTeX(), tt.wantLaTeX)
+			}
+		})
+	}
+}
+
+func TestFraction_FactorLaTeX(t *testing.T) {
+	if got, want := NewFraction(12, 35).FactorLaTeX(), `\dfrac{2^{2}\cdot 3}{5\cdot 7}`; got != want {
+		t.Errorf("Fraction.FactorLaTeX() = %v, want %v", got, want)
+	}
+
+	if got, want := NewInteger(8).FactorLaTeX(), `2^{3}`; got != want {
+		t.Errorf("Fraction.FactorLaTeX() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,319 @@
+package basicmath
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// primes below this bound are found by trial division before falling back to Pollard's rho
+const trialDivisionLimit = 1000
+
+// witnesses that make Miller–Rabin deterministic for every 64-bit integer
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+var smallPrimes = PrimesUpTo(trialDivisionLimit)
+
+// #region Public Methods
+
+// IsPrime reports whether n is prime using a deterministic Miller–Rabin test
+func IsPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+
+	return isPrime64(uint64(n))
+}
+
+// NextPrime returns the smallest prime greater than n; it panics with ErrOverflow past the largest int prime
+func NextPrime(n int) int {
+	if n < 2 {
+		return 2
+	}
+
+	candidate := n + 1
+	if candidate%2 == 0 && candidate != 2 {
+		candidate++
+	}
+
+	for ; candidate > 0; candidate += 2 {
+		if IsPrime(candidate) {
+			return candidate
+		}
+	}
+
+	panic(ErrOverflow)
+}
+
+// PrimeFactors returns the prime factors of |n| in ascending order, repeated by multiplicity
+func PrimeFactors(n int) []int {
+	var value uint64
+	if n < 0 {
+		value = uint64(-(n + 1)) + 1 // avoids overflow for math.MinInt
+	} else {
+		value = uint64(n)
+	}
+
+	if value < 2 {
+		return []int{}
+	}
+
+	factors := []int{}
+	for _, p := range smallPrimes {
+		prime := uint64(p)
+		if prime*prime > value {
+			break
+		}
+		for value%prime == 0 {
+			factors = append(factors, p)
+			value /= prime
+		}
+	}
+
+	if value > 1 {
+		for _, factor := range pollardFactors(value) {
+			factors = append(factors, int(factor))
+		}
+	}
+
+	sort.Ints(factors)
+
+	return factors
+}
+
+// PrimesInRange returns the primes p with low <= p <= high using a segmented sieve. A range narrower
+// than √high, such as 100 numbers near 10^18, is tested number by number with IsPrime instead, since
+// the sieve would first need every prime up to √high
+func PrimesInRange(low, high int) []int {
+	if low < 2 {
+		low = 2
+	}
+	if high < low {
+		return []int{}
+	}
+
+	limit := int(math.Sqrt(float64(high))) + 1
+	primes := []int{}
+
+	if high-low < limit {
+		for n := low; n <= high && n >= low; n++ {
+			if IsPrime(n) {
+				primes = append(primes, n)
+			}
+		}
+		return primes
+	}
+
+	basePrimes := PrimesUpTo(limit)
+
+	const segmentSize = 1 << 15
+	composite := make([]bool, segmentSize)
+
+	for start := low; start <= high; start += segmentSize {
+		end := start + segmentSize - 1
+		if end > high || end < start {
+			end = high
+		}
+
+		for i := range composite {
+			composite[i] = false
+		}
+
+		for _, p := range basePrimes {
+			if p*p > end {
+				break
+			}
+
+			first := ((start + p - 1) / p) * p
+			if first < p*p {
+				first = p * p
+			}
+
+			for multiple := first; multiple <= end && multiple >= first; multiple += p {
+				composite[multiple-start] = true
+			}
+		}
+
+		for i := 0; i <= end-start; i++ {
+			if !composite[i] {
+				primes = append(primes, start+i)
+			}
+		}
+
+		if end == high {
+			break
+		}
+	}
+
+	return primes
+}
+
+// PrimesUpTo returns every prime p <= n using the sieve of Eratosthenes
+func PrimesUpTo(n int) []int {
+	if n < 2 {
+		return []int{}
+	}
+
+	composite := make([]bool, n+1)
+	primes := []int{}
+
+	for i := 2; i <= n; i++ {
+		if composite[i] {
+			continue
+		}
+
+		primes = append(primes, i)
+		for multiple := i * i; multiple <= n; multiple += i {
+			composite[multiple] = true
+		}
+	}
+
+	return primes
+}
+
+// #endregion
+
+// #region Private Methods
+
+func isPrime64(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+
+	// write n-1 as d * 2^s with d odd
+	d := n - 1
+	s := 0
+	for d%2 == 0 {
+		d /= 2
+		s++
+	}
+
+	for _, a := range millerRabinBases {
+		x := powMod64(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+
+		composite := true
+		for i := 1; i < s; i++ {
+			x = mulMod64(x, x, n)
+			if x == n-1 {
+				composite = false
+				break
+			}
+		}
+
+		if composite {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mulMod64(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, rem := bits.Div64(hi%m, lo, m)
+	return rem
+}
+
+func powMod64(base, exp, m uint64) uint64 {
+	result := uint64(1) % m
+	base %= m
+
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = mulMod64(result, base, m)
+		}
+		base = mulMod64(base, base, m)
+		exp >>= 1
+	}
+
+	return result
+}
+
+func gcd64(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// splits n into prime factors (unsorted) using Pollard's rho with Brent's cycle detection
+func pollardFactors(n uint64) []uint64 {
+	if n == 1 {
+		return nil
+	}
+	if isPrime64(n) {
+		return []uint64{n}
+	}
+
+	divisor := pollardRho(n)
+
+	return append(pollardFactors(divisor), pollardFactors(n/divisor)...)
+}
+
+// returns a non-trivial divisor of the odd composite n
+func pollardRho(n uint64) uint64 {
+	if n%2 == 0 {
+		return 2
+	}
+
+	for c := uint64(1); ; c++ {
+		f := func(x uint64) uint64 {
+			return (mulMod64(x, x, n) + c) % n
+		}
+
+		y, r, q := uint64(2), uint64(1), uint64(1)
+		var x, ys, g uint64 = 0, 0, 1
+		const batch = 128
+
+		for g == 1 {
+			x = y
+			for i := uint64(0); i < r; i++ {
+				y = f(y)
+			}
+
+			for k := uint64(0); k < r && g == 1; k += batch {
+				ys = y
+				for i := uint64(0); i < batch && i < r-k; i++ {
+					y = f(y)
+					diff := x - y
+					if x < y {
+						diff = y - x
+					}
+					q = mulMod64(q, diff, n)
+				}
+				g = gcd64(q, n)
+			}
+			r *= 2
+		}
+
+		if g == n {
+			// the batch overshot; step through it one value at a time
+			for {
+				ys = f(ys)
+				diff := x - ys
+				if x < ys {
+					diff = ys - x
+				}
+				g = gcd64(diff, n)
+				if g > 1 {
+					break
+				}
+			}
+		}
+
+		if g != n {
+			return g
+		}
+	}
+}
+
+// #endregion
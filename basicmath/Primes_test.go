@@ -0,0 +1,114 @@
+package basicmath
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestIsPrime(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want bool
+	}{
+		{name: "Primes_IsPrime_Negative", n: -7, want: false},
+		{name: "Primes_IsPrime_One", n: 1, want: false},
+		{name: "Primes_IsPrime_Two", n: 2, want: true},
+		{name: "Primes_IsPrime_Small", n: 97, want: true},
+		{name: "Primes_IsPrime_Carmichael", n: 561, want: false},
+		{name: "Primes_IsPrime_StrongPseudoprime", n: 3215031751, want: false},
+		{name: "Primes_IsPrime_12Digit", n: 999999999989, want: true},
+		{name: "Primes_IsPrime_Mersenne61", n: 2305843009213693951, want: true},
+		{name: "Primes_IsPrime_LargestInt64", n: math.MaxInt64 - 24, want: true},
+		{name: "Primes_IsPrime_MaxInt64", n: math.MaxInt64, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPrime(tt.n); got != tt.want {
+				t.Errorf("IsPrime(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrimeFactors(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "Primes_PrimeFactors_Zero", n: 0, want: []int{}},
+		{name: "Primes_PrimeFactors_One", n: 1, want: []int{}},
+		{name: "Primes_PrimeFactors_Test01", n: 360, want: []int{2, 2, 2, 3, 3, 5}},
+		{name: "Primes_PrimeFactors_Negative", n: -12, want: []int{2, 2, 3}},
+		{name: "Primes_PrimeFactors_12Digit", n: 600851475143, want: []int{71, 839, 1471, 6857}},
+		{name: "Primes_PrimeFactors_Semiprime", n: 999999000001 * 7, want: []int{7, 999999000001}},
+		{name: "Primes_PrimeFactors_LargeSemiprime", n: 1000000007 * 998244353, want: []int{998244353, 1000000007}},
+		{name: "Primes_PrimeFactors_PrimePower", n: 1000003 * 1000003, want: []int{1000003, 1000003}},
+		{name: "Primes_PrimeFactors_MinInt", n: math.MinInt64, want: []int{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PrimeFactors(tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrimeFactors(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrimesUpTo(t *testing.T) {
+	want := []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}
+	if got := PrimesUpTo(30); !reflect.DeepEqual(got, want) {
+		t.Errorf("PrimesUpTo(30) = %v, want %v", got, want)
+	}
+
+	if got := PrimesUpTo(1); len(got) != 0 {
+		t.Errorf("PrimesUpTo(1) = %v, want []", got)
+	}
+}
+
+func TestPrimesInRange(t *testing.T) {
+	tests := []struct {
+		name string
+		low  int
+		high int
+		want []int
+	}{
+		{name: "Primes_PrimesInRange_Test01", low: 0, high: 20, want: []int{2, 3, 5, 7, 11, 13, 17, 19}},
+		{name: "Primes_PrimesInRange_Test02", low: 90, high: 110, want: []int{97, 101, 103, 107, 109}},
+		{name: "Primes_PrimesInRange_Large", low: 999999999900, high: 1000000000000, want: []int{999999999937, 999999999959, 999999999961, 999999999989}},
+		{name: "Primes_PrimesInRange_NearMaxInt", low: math.MaxInt - 100, high: math.MaxInt, want: []int{9223372036854775783}},
+		{name: "Primes_PrimesInRange_Empty", low: 24, high: 28, want: []int{}},
+		{name: "Primes_PrimesInRange_Reversed", low: 30, high: 10, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PrimesInRange(tt.low, tt.high); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrimesInRange(%d, %d) = %v, want %v", tt.low, tt.high, got, tt.want)
+			}
+		})
+	}
+
+	// crosses several sieve segments
+	if got, want := len(PrimesInRange(1, 200000)), 17984; got != want {
+		t.Errorf("len(PrimesInRange(1, 200000)) = %d, want %d", got, want)
+	}
+}
+
+func TestNextPrime(t *testing.T) {
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{n: -5, want: 2},
+		{n: 2, want: 3},
+		{n: 13, want: 17},
+		{n: 999999999961, want: 999999999989},
+	}
+	for _, tt := range tests {
+		if got := NextPrime(tt.n); got != tt.want {
+			t.Errorf("NextPrime(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package basicmath
+
+import "fmt"
+
+// #region Public Methods
+
+// SolveProportion solves a/b = c/d for the one term passed as nil, e.g. 3/4 = 15/x gives x = 20.
+// ErrZeroDenominator is returned when b or d is a known zero, and ErrNoSolution when the unknown
+// cannot be found, as in 0/4 = 5/x, or would be a zero denominator, as in 0/x = 3/4.
+func SolveProportion(a, b, c, d *Fraction) (*Fraction, error) {
+	return solveProportion(a, b, c, d, nil)
+}
+
+// SolveProportionWithSteps solves like SolveProportion and also returns the work: cross-multiplying,
+// multiplying out the known products and dividing both sides by the coefficient of x
+func SolveProportionWithSteps(a, b, c, d *Fraction) (*Fraction, *StepLog, error) {
+	steps := &StepLog{}
+	x, err := solveProportion(a, b, c, d, steps)
+	if err != nil {
+		return nil, nil, err
+	}
+	return x, steps, nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+func solveProportion(a, b, c, d *Fraction, steps *StepLog) (*Fraction, error) {
+	terms := []*Fraction{a, b, c, d}
+	unknown := -1
+	for i, term := range terms {
+		if term != nil {
+			continue
+		}
+		if unknown >= 0 {
+			return nil, fmt.Errorf("basicmath: a proportion must have exactly one unknown term")
+		}
+		unknown = i
+	}
+	if unknown < 0 {
+		return nil, fmt.Errorf("basicmath: a proportion must have exactly one unknown term")
+	}
+
+	for _, i := range []int{1, 3} {
+		if i != unknown && terms[i].n == 0 {
+			return nil, ErrZeroDenominator
+		}
+	}
+
+	latexOf := func(i int) string {
+		if i == unknown {
+			return "x"
+		}
+		return terms[i].LaTeX()
+	}
+	steps.Add("", fmt.Sprintf(`\dfrac{%s}{%s} = \dfrac{%s}{%s}`, latexOf(0), latexOf(1), latexOf(2), latexOf(3)))
+	steps.Add("cross-multiply", fmt.Sprintf(`%s \cdot %s = %s \cdot %s`,
+		parenthesizeFactor(terms[0], unknown == 0), parenthesizeFactor(terms[3], unknown == 3),
+		parenthesizeFactor(terms[1], unknown == 1), parenthesizeFactor(terms[2], unknown == 2)))
+
+	// ad = bc; the unknown shares its side with one known term and the other side is fully known
+	partner := map[int]int{0: 3, 3: 0, 1: 2, 2: 1}
+	opposite := map[int][2]int{0: {1, 2}, 3: {1, 2}, 1: {0, 3}, 2: {0, 3}}
+
+	coefficient := terms[partner[unknown]]
+	product, err := terms[opposite[unknown][0]].TryMultiply(terms[opposite[unknown][1]])
+	if err != nil {
+		return nil, err
+	}
+	product = product.simplifiedCopy()
+
+	unknownSide := coefficient.LaTeX() + "x"
+	switch {
+	case coefficient.Equals(NewInteger(1)):
+		unknownSide = "x"
+	case coefficient.Equals(NewInteger(-1)):
+		unknownSide = "-x"
+	}
+	if unknown == 0 || unknown == 3 {
+		steps.Add("multiply", fmt.Sprintf("%s = %s", unknownSide, product.LaTeX()))
+	} else {
+		steps.Add("multiply", fmt.Sprintf("%s = %s", product.LaTeX(), unknownSide))
+	}
+
+	if coefficient.n == 0 {
+		return nil, ErrNoSolution
+	}
+	if product.n == 0 && (unknown == 1 || unknown == 3) {
+		// x = 0 would be a zero denominator, as in 0/x = 3/4
+		return nil, ErrNoSolution
+	}
+
+	x, err := product.TryDivide(coefficient)
+	if err != nil {
+		return nil, err
+	}
+	x = x.simplifiedCopy()
+
+	if !coefficient.Equals(NewInteger(1)) {
+		quotient := fmt.Sprintf(`%s \div %s`, product.LaTeX(), parenthesizeLaTeX(coefficient))
+		if product.IsInteger() && coefficient.IsInteger() && coefficient.n > 0 {
+			quotient = fmt.Sprintf(`\dfrac{%d}{%d}`, product.n, coefficient.n)
+		}
+		steps.Add(fmt.Sprintf("divide both sides by %s", coefficient), fmt.Sprintf("x = %s = %s", quotient, x.LaTeX()))
+	}
+
+	return x, nil
+}
+
+// writes x for the unknown, and wraps a known negative or fractional factor in parentheses
+func parenthesizeFactor(f *Fraction, unknown bool) string {
+	if unknown {
+		return "x"
+	}
+	if f.n < 0 || !f.IsInteger() {
+		return fmt.Sprintf(`\left(%s\right)`, f.LaTeX())
+	}
+	return f.LaTeX()
+}
+
+// #endregion
@@ -0,0 +1,105 @@
+package basicmath
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSolveProportion(t *testing.T) {
+	tests := []struct {
+		name       string
+		a, b, c, d *Fraction
+		want       *Fraction
+	}{
+		{"SolveProportion_Fourth", NewInteger(3), NewInteger(4), NewInteger(15), nil, NewInteger(20)},
+		{"SolveProportion_First", nil, NewInteger(4), NewInteger(15), NewInteger(20), NewInteger(3)},
+		{"SolveProportion_Second", NewInteger(3), nil, NewInteger(15), NewInteger(20), NewInteger(4)},
+		{"SolveProportion_Third", NewInteger(3), NewInteger(4), nil, NewInteger(20), NewInteger(15)},
+		{"SolveProportion_FractionAnswer", NewInteger(2), NewInteger(3), NewInteger(5), nil, NewFraction(15, 2)},
+		{"SolveProportion_FractionTerms", NewFraction(1, 2), NewInteger(3), NewInteger(2), nil, NewInteger(12)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SolveProportion(tt.a, tt.b, tt.c, tt.d)
+			if err != nil {
+				t.Fatalf("SolveProportion() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("SolveProportion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSolveProportion_Errors(t *testing.T) {
+	if _, err := SolveProportion(NewInteger(1), NewInteger(0), NewInteger(2), nil); !errors.Is(err, ErrZeroDenominator) {
+		t.Errorf("SolveProportion() error = %v, want %v", err, ErrZeroDenominator)
+	}
+	if _, err := SolveProportion(NewInteger(0), NewInteger(4), NewInteger(5), nil); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("SolveProportion() error = %v, want %v", err, ErrNoSolution)
+	}
+	if _, err := SolveProportion(NewInteger(0), nil, NewInteger(3), NewInteger(4)); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("SolveProportion(0/x = 3/4) error = %v, want %v", err, ErrNoSolution)
+	}
+	if _, err := SolveProportion(NewInteger(3), NewInteger(4), NewInteger(0), nil); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("SolveProportion(3/4 = 0/x) error = %v, want %v", err, ErrNoSolution)
+	}
+	if _, _, err := SolveProportionWithSteps(NewInteger(0), nil, NewInteger(3), NewInteger(4)); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("SolveProportionWithSteps(0/x = 3/4) error = %v, want %v", err, ErrNoSolution)
+	}
+	if _, err := SolveProportion(NewInteger(1), NewInteger(2), nil, nil); err == nil {
+		t.Errorf("SolveProportion() with two unknowns should fail")
+	}
+	if _, err := SolveProportion(NewInteger(1), NewInteger(2), NewInteger(3), NewInteger(6)); err == nil {
+		t.Errorf("SolveProportion() with no unknown should fail")
+	}
+}
+
+func TestSolveProportionWithSteps(t *testing.T) {
+	got, steps, err := SolveProportionWithSteps(NewInteger(3), NewInteger(4), NewInteger(15), nil)
+	if err != nil {
+		t.Fatalf("SolveProportionWithSteps() error = %v", err)
+	}
+	if !got.Equals(NewInteger(20)) {
+		t.Errorf("SolveProportionWithSteps() = %v, want 20", got)
+	}
+
+	want := []Step{
+		{"", `\dfrac{3}{4} = \dfrac{15}{x}`},
+		{"cross-multiply", `3 \cdot x = 4 \cdot 15`},
+		{"multiply", `3x = 60`},
+		{"divide both sides by 3", `x = \dfrac{60}{3} = 20`},
+	}
+	gotSteps := steps.Steps()
+	if len(gotSteps) != len(want) {
+		t.Fatalf("SolveProportionWithSteps() gave %d steps, want %d:\n%s", len(gotSteps), len(want), steps)
+	}
+	for i := range want {
+		if gotSteps[i] != want[i] {
+			t.Errorf("step %d = %+v, want %+v", i+1, gotSteps[i], want[i])
+		}
+	}
+}
+
+func TestSolveProportionWithSteps_UnknownNumerator(t *testing.T) {
+	_, steps, err := SolveProportionWithSteps(nil, NewInteger(1), NewFraction(1, 2), NewInteger(4))
+	if err != nil {
+		t.Fatalf("SolveProportionWithSteps() error = %v", err)
+	}
+
+	want := []string{
+		`\dfrac{x}{1} = \dfrac{\dfrac{1}{2}}{4}`,
+		`x \cdot 4 = 1 \cdot \left(\dfrac{1}{2}\right)`,
+		`4x = \dfrac{1}{2}`,
+		`x = \dfrac{1}{2} \div 4 = \dfrac{1}{8}`,
+	}
+	gotSteps := steps.Steps()
+	if len(gotSteps) != len(want) {
+		t.Fatalf("SolveProportionWithSteps() gave %d steps, want %d:\n%s", len(gotSteps), len(want), steps)
+	}
+	for i := range want {
+		if gotSteps[i].Expression != want[i] {
+			t.Errorf("step %d = %v, want %v", i+1, gotSteps[i].Expression, want[i])
+		}
+	}
+}
@@ -0,0 +1,574 @@
+package basicmath
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// #region Constructor
+
+// Radical is an exact value of the form coefficient × ⁿ√radicand, e.g. 6√2 or (1/2)∛3.
+// Radicals are always kept in simplest form: the radicand has no perfect index-th power factors
+// and the index is as small as possible. A rational value has a radicand of 1.
+type Radical struct {
+	coefficient *Fraction
+	radicand    int
+	index       int
+}
+
+// NewRadical builds coefficient × ⁱⁿᵈᵉˣ√radicand in simplest form.
+// It panics with ErrInvalidRootIndex for an index below 2, ErrNegativeRadicand for an even root of a negative number,
+// or ErrOverflow; use NewRadicalE to get the error instead.
+func NewRadical(coefficient *Fraction, radicand int, index int) *Radical {
+	return mustRadical(NewRadicalE(coefficient, radicand, index))
+}
+
+// NewRadicalE builds a radical, returning an error instead of panicking
+func NewRadicalE(coefficient *Fraction, radicand int, index int) (*Radical, error) {
+	if index < 2 {
+		return nil, ErrInvalidRootIndex
+	}
+
+	temp := coefficient.simplifiedCopy()
+
+	if radicand < 0 {
+		if index%2 == 0 {
+			return nil, ErrNegativeRadicand
+		}
+		if radicand == math.MinInt {
+			return nil, ErrOverflow
+		}
+
+		// an odd root of a negative number is the negative of the root
+		radicand = -radicand
+		temp.n = -temp.n
+	}
+
+	r := &Radical{coefficient: temp, radicand: radicand, index: index}
+	if err := r.simplify(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NewSquareRoot returns √radicand in simplest form, e.g. √72 becomes 6√2
+func NewSquareRoot(radicand int) *Radical {
+	return NewRadical(NewInteger(1), radicand, 2)
+}
+
+// NewCubeRoot returns ∛radicand in simplest form, e.g. ∛16 becomes 2∛2
+func NewCubeRoot(radicand int) *Radical {
+	return NewRadical(NewInteger(1), radicand, 3)
+}
+
+// NewRationalRadical wraps a fraction as a radical with a radicand of 1
+func NewRationalRadical(value *Fraction) *Radical {
+	return &Radical{coefficient: value.simplifiedCopy(), radicand: 1, index: 1}
+}
+
+// #endregion
+
+// #region Properties
+
+// Coefficient returns the rational factor in front of the root
+func (r *Radical) Coefficient() *Fraction {
+	return NewFraction(r.coefficient.n, r.coefficient.d)
+}
+
+// Radicand returns the number under the root; it is 1 for a rational value
+func (r *Radical) Radicand() int {
+	return r.radicand
+}
+
+// Index returns the root index, e.g. 2 for a square root; it is 1 for a rational value
+func (r *Radical) Index() int {
+	return r.index
+}
+
+// #endregion
+
+// #region Comparable
+
+func (r *Radical) Compare(other *Radical) int {
+	signR, signOther := r.coefficient.sign(), other.coefficient.sign()
+	if signR != signOther {
+		if signR < signOther {
+			return -1
+		}
+		return 1
+	}
+	if signR == 0 {
+		return 0
+	}
+
+	// raise both sides to the common index so the roots disappear
+	index := LCM(r.index, other.index)
+	left := r.power(index)
+	right := other.power(index)
+
+	if signR > 0 {
+		return left.Cmp(right)
+	}
+	return right.Cmp(left)
+}
+
+func (r *Radical) Equals(other *Radical) bool {
+	return r.Compare(other) == 0
+}
+
+func (r *Radical) GreaterThan(other *Radical) bool {
+	return r.Compare(other) > 0
+}
+
+func (r *Radical) GreaterThanOrEqualTo(other *Radical) bool {
+	return r.Compare(other) >= 0
+}
+
+func (r *Radical) LessThan(other *Radical) bool {
+	return r.Compare(other) < 0
+}
+
+func (r *Radical) LessThanOrEqualTo(other *Radical) bool {
+	return r.Compare(other) <= 0
+}
+
+// #endregion
+
+// #region LaTeXer
+
+// LaTeX renders the radical, e.g. 6\sqrt{2}, \sqrt[3]{2} or \dfrac{3\sqrt{2}}{4}
+func (r *Radical) LaTeX() string {
+	if r.IsRational() {
+		return r.coefficient.LaTeX()
+	}
+
+	root := fmt.Sprintf(`\sqrt{%d}`, r.radicand)
+	if r.index != 2 {
+		root = fmt.Sprintf(`\sqrt[%d]{%d}`, r.index, r.radicand)
+	}
+
+	sign := ""
+	if r.coefficient.n < 0 {
+		sign = "-"
+	}
+
+	numerator := Abs(r.coefficient.n)
+	top := root
+	if numerator != 1 {
+		top = fmt.Sprintf("%d%s", numerator, root)
+	}
+
+	if r.coefficient.d == 1 {
+		return sign + top
+	}
+
+	return fmt.Sprintf(`%s\dfrac{%s}{%d}`, sign, top, r.coefficient.d)
+}
+
+// #endregion
+
+// #region Operable
+
+// Add panics with ErrUnlikeRadicals unless every radical has the same radicand and index (or is zero); see TryAdd.
+func (r *Radical) Add(others ...*Radical) *Radical {
+	return mustRadical(r.TryAdd(others...))
+}
+
+// Divide returns the quotient with a rationalized denominator; see TryDivide.
+func (r *Radical) Divide(others ...*Radical) *Radical {
+	return mustRadical(r.TryDivide(others...))
+}
+
+// Multiply panics with ErrOverflow if the radicand grows too large; see TryMultiply.
+func (r *Radical) Multiply(others ...*Radical) *Radical {
+	return mustRadical(r.TryMultiply(others...))
+}
+
+// Subtract panics with ErrUnlikeRadicals unless every radical has the same radicand and index (or is zero); see TrySubtract.
+func (r *Radical) Subtract(others ...*Radical) *Radical {
+	return mustRadical(r.TrySubtract(others...))
+}
+
+// TryAdd combines like radicals, e.g. 2√3 + 5√3 = 7√3
+func (r *Radical) TryAdd(others ...*Radical) (*Radical, error) {
+	temp := r.copy()
+
+	for _, other := range others {
+		switch {
+		case other.IsZero():
+			continue
+		case temp.IsZero():
+			temp = other.copy()
+			continue
+		case !temp.IsLike(other):
+			return nil, ErrUnlikeRadicals
+		}
+
+		coefficient, err := temp.coefficient.TryAdd(other.coefficient)
+		if err != nil {
+			return nil, err
+		}
+		temp.coefficient = coefficient
+	}
+
+	temp.normalizeZero()
+
+	return temp, nil
+}
+
+// TryDivide divides and rationalizes the denominator, e.g. 1 / √2 = √2/2
+func (r *Radical) TryDivide(others ...*Radical) (*Radical, error) {
+	temp := r.copy()
+
+	for _, other := range others {
+		reciprocal, err := other.TryReciprocal()
+		if err != nil {
+			return nil, err
+		}
+
+		temp, err = temp.TryMultiply(reciprocal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return temp, nil
+}
+
+// TryMultiply multiplies radicals, rewriting roots with different indexes over their common index,
+// e.g. √2 × ∛2 = ⁶√32
+func (r *Radical) TryMultiply(others ...*Radical) (*Radical, error) {
+	temp := r.copy()
+
+	for _, other := range others {
+		coefficient, err := temp.coefficient.TryMultiply(other.coefficient)
+		if err != nil {
+			return nil, err
+		}
+
+		index := LCM(temp.index, other.index)
+		left, leftOk := powInt(temp.radicand, index/temp.index)
+		right, rightOk := powInt(other.radicand, index/other.index)
+		if !leftOk || !rightOk {
+			return nil, ErrOverflow
+		}
+
+		radicand, ok := multiplyInts(left, right)
+		if !ok {
+			return nil, ErrOverflow
+		}
+
+		temp = &Radical{coefficient: coefficient, radicand: radicand, index: index}
+		if err := temp.simplify(); err != nil {
+			return nil, err
+		}
+	}
+
+	return temp, nil
+}
+
+// TrySubtract combines like radicals, e.g. 5√3 - 2√3 = 3√3
+func (r *Radical) TrySubtract(others ...*Radical) (*Radical, error) {
+	temp := r.copy()
+
+	for _, other := range others {
+		negated := other.copy()
+		negated.coefficient = NewFraction(-other.coefficient.n, other.coefficient.d)
+
+		var err error
+		temp, err = temp.TryAdd(negated)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return temp, nil
+}
+
+// #endregion
+
+// #region Simplifiable
+
+// Simplify is a no-op because radicals are always stored in simplest form
+func (r *Radical) Simplify() {}
+
+// #endregion
+
+// #region Stringer
+
+// String renders the radical in plain text, e.g. 6√2, -∛2 or (3/4)√2
+func (r *Radical) String() string {
+	if r.IsRational() {
+		return r.coefficient.String()
+	}
+
+	root := rootSymbol(r.index) + fmt.Sprintf("%d", r.radicand)
+
+	switch {
+	case r.coefficient.d != 1:
+		return fmt.Sprintf("(%s)%s", r.coefficient, root)
+	case r.coefficient.n == 1:
+		return root
+	case r.coefficient.n == -1:
+		return "-" + root
+	}
+
+	return fmt.Sprintf("%d%s", r.coefficient.n, root)
+}
+
+// #endregion
+
+// #region Public Methods
+
+// IsLike reports whether both radicals have the same radicand and index, so they can be added
+func (r *Radical) IsLike(other *Radical) bool {
+	return r.radicand == other.radicand && r.index == other.index
+}
+
+// IsRational reports whether the root simplified away completely
+func (r *Radical) IsRational() bool {
+	return r.radicand == 1
+}
+
+func (r *Radical) IsZero() bool {
+	return r.coefficient.n == 0
+}
+
+// ToFraction returns the rational value and true when the radical is rational
+func (r *Radical) ToFraction() (fraction *Fraction, ok bool) {
+	if !r.IsRational() {
+		return nil, false
+	}
+	return NewFraction(r.coefficient.n, r.coefficient.d), true
+}
+
+func (r *Radical) ToFloat64() float64 {
+	return r.coefficient.ToFloat64() * math.Pow(float64(r.radicand), 1/float64(r.index))
+}
+
+// Reciprocal returns 1/r with a rationalized denominator; it panics with ErrDivisionByZero for zero, see TryReciprocal.
+func (r *Radical) Reciprocal() *Radical {
+	return mustRadical(r.TryReciprocal())
+}
+
+// TryReciprocal returns 1/r with a rationalized denominator, e.g. 1/(2∛3) = ∛9/6
+func (r *Radical) TryReciprocal() (*Radical, error) {
+	if r.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+
+	// 1/(a ⁿ√x) = ⁿ√(x^(n-1)) / (a x)
+	radicand, ok := powInt(r.radicand, r.index-1)
+	if !ok {
+		return nil, ErrOverflow
+	}
+
+	denominator, err := r.coefficient.TryMultiply(NewInteger(r.radicand))
+	if err != nil {
+		return nil, err
+	}
+
+	coefficient, err := NewInteger(1).TryDivide(denominator)
+	if err != nil {
+		return nil, err
+	}
+
+	reciprocal := &Radical{coefficient: coefficient, radicand: radicand, index: r.index}
+	if err := reciprocal.simplify(); err != nil {
+		return nil, err
+	}
+
+	return reciprocal, nil
+}
+
+// RationalizeDenominator rewrites numerator / (rational + root) for a square root using the conjugate,
+// returning p and q with numerator / (rational + root) = p + q, e.g. 1/(2 + √3) = 2 - √3.
+func RationalizeDenominator(numerator *Fraction, rational *Fraction, root *Radical) (*Fraction, *Radical, error) {
+	if root.IsRational() || root.index != 2 {
+		return nil, nil, fmt.Errorf("basicmath: can only rationalize a rational number plus a square root, got %s", root)
+	}
+
+	// (rational + root)(rational - root) = rational² - coefficient² × radicand
+	rationalSquared, err := rational.TryMultiply(rational)
+	if err != nil {
+		return nil, nil, err
+	}
+	rootSquared, err := root.coefficient.TryMultiply(root.coefficient, NewInteger(root.radicand))
+	if err != nil {
+		return nil, nil, err
+	}
+	denominator, err := rationalSquared.TrySubtract(rootSquared)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scale, err := numerator.TryDivide(denominator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rationalPart, err := rational.TryMultiply(scale)
+	if err != nil {
+		return nil, nil, err
+	}
+	coefficient, err := root.coefficient.TryMultiply(scale)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	radicalPart := &Radical{coefficient: NewFraction(-coefficient.n, coefficient.d), radicand: root.radicand, index: 2}
+	radicalPart.normalizeZero()
+
+	return rationalPart, radicalPart, nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+func mustRadical(r *Radical, err error) *Radical {
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+func (r *Radical) copy() *Radical {
+	return &Radical{coefficient: NewFraction(r.coefficient.n, r.coefficient.d), radicand: r.radicand, index: r.index}
+}
+
+// moves perfect powers out of the radicand and lowers the index where possible, e.g. ⁴√36 becomes √6
+func (r *Radical) simplify() error {
+	if r.radicand == 0 || r.coefficient.n == 0 {
+		r.coefficient = NewInteger(0)
+		r.normalizeZero()
+		return nil
+	}
+
+	factors := Factorize(r.radicand).Factors()
+
+	outside := 1
+	remaining := []PrimeFactor{}
+	for _, factor := range factors {
+		for i := 0; i < factor.Exponent/r.index; i++ {
+			outside *= factor.Prime
+		}
+		if exponent := factor.Exponent % r.index; exponent > 0 {
+			remaining = append(remaining, PrimeFactor{Prime: factor.Prime, Exponent: exponent})
+		}
+	}
+
+	// ⁴√(2²) = √2: divide the index and every exponent by their common factor
+	index := r.index
+	common := index
+	for _, factor := range remaining {
+		common = GCF(common, factor.Exponent)
+	}
+	if len(remaining) == 0 {
+		index, common = 1, 1
+	}
+	index /= common
+
+	radicand := 1
+	for _, factor := range remaining {
+		for i := 0; i < factor.Exponent/common; i++ {
+			radicand *= factor.Prime
+		}
+	}
+
+	coefficient, err := r.coefficient.TryMultiply(NewInteger(outside))
+	if err != nil {
+		return err
+	}
+
+	r.coefficient = coefficient.simplifiedCopy()
+	r.radicand = radicand
+	r.index = index
+
+	return nil
+}
+
+// zero and rational values keep a radicand and index of 1 so that Equals and IsLike behave
+func (r *Radical) normalizeZero() {
+	if r.coefficient.n == 0 {
+		r.radicand = 1
+		r.index = 1
+	}
+}
+
+// returns |r|^index as an exact rational
+func (r *Radical) power(index int) *big.Rat {
+	exponent := big.NewInt(int64(index))
+	numerator := new(big.Int).Exp(big.NewInt(int64(Abs(r.coefficient.n))), exponent, nil)
+	denominator := new(big.Int).Exp(big.NewInt(int64(r.coefficient.d)), exponent, nil)
+	radicand := new(big.Int).Exp(big.NewInt(int64(r.radicand)), big.NewInt(int64(index/r.index)), nil)
+
+	numerator.Mul(numerator, radicand)
+
+	return new(big.Rat).SetFrac(numerator, denominator)
+}
+
+func (f *Fraction) sign() int {
+	switch {
+	case f.n > 0:
+		return 1
+	case f.n < 0:
+		return -1
+	}
+	return 0
+}
+
+// returns base^exponent by repeated squaring, reporting false on overflow; exponents below 1 give 1
+func powInt(base, exponent int) (int, bool) {
+	switch {
+	case exponent <= 0 || base == 1:
+		return 1, true
+	case base == 0:
+		return 0, true
+	case base == -1:
+		if exponent%2 == 0 {
+			return 1, true
+		}
+		return -1, true
+	}
+
+	result := 1
+	for {
+		var ok bool
+		if exponent%2 == 1 {
+			if result, ok = multiplyInts(result, base); !ok {
+				return 0, false
+			}
+		}
+		exponent /= 2
+		if exponent == 0 {
+			return result, true
+		}
+		if base, ok = multiplyInts(base, base); !ok {
+			return 0, false
+		}
+	}
+}
+
+var superscriptDigits = strings.NewReplacer(
+	"0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴",
+	"5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹",
+)
+
+func rootSymbol(index int) string {
+	switch index {
+	case 2:
+		return "√"
+	case 3:
+		return "∛"
+	case 4:
+		return "∜"
+	}
+	return superscriptDigits.Replace(fmt.Sprintf("%d", index)) + "√"
+}
+
+// #endregion
@@ -0,0 +1,150 @@
+package basicmath
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewRadical(t *testing.T) {
+	tests := []struct {
+		name        string
+		coefficient *Fraction
+		radicand    int
+		index       int
+		want        string
+		wantLaTeX   string
+	}{
+		{name: "Radical_New_Square", coefficient: NewInteger(1), radicand: 72, index: 2, want: "6√2", wantLaTeX: `6\sqrt{2}`},
+		{name: "Radical_New_Cube", coefficient: NewInteger(1), radicand: 16, index: 3, want: "2∛2", wantLaTeX: `2\sqrt[3]{2}`},
+		{name: "Radical_New_Perfect", coefficient: NewInteger(2), radicand: 49, index: 2, want: "14", wantLaTeX: "14"},
+		{name: "Radical_New_LowerIndex", coefficient: NewInteger(1), radicand: 36, index: 4, want: "√6", wantLaTeX: `\sqrt{6}`},
+		{name: "Radical_New_NegativeOddRoot", coefficient: NewInteger(1), radicand: -54, index: 3, want: "-3∛2", wantLaTeX: `-3\sqrt[3]{2}`},
+		{name: "Radical_New_FractionCoefficient", coefficient: NewFraction(3, 8), radicand: 8, index: 2, want: "(3/4)√2", wantLaTeX: `\dfrac{3\sqrt{2}}{4}`},
+		{name: "Radical_New_NegativeFraction", coefficient: NewFraction(-1, 2), radicand: 5, index: 5, want: "(-1/2)⁵√5", wantLaTeX: `-\dfrac{\sqrt[5]{5}}{2}`},
+		{name: "Radical_New_Zero", coefficient: NewInteger(3), radicand: 0, index: 2, want: "0", wantLaTeX: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewRadical(tt.coefficient, tt.radicand, tt.index)
+			if got.String() != tt.want {
+				t.Errorf("NewRadical().String() = %q, want %q", got.String(), tt.want)
+			}
+			if got.LaTeX() != tt.wantLaTeX {
+				t.Errorf("NewRadical().LaTeX() = %q, want %q", got.LaTeX(), tt.wantLaTeX)
+			}
+		})
+	}
+}
+
+func TestNewRadicalE_Errors(t *testing.T) {
+	if _, err := NewRadicalE(NewInteger(1), -4, 2); !errors.Is(err, ErrNegativeRadicand) {
+		t.Errorf("NewRadicalE(√-4) error = %v, want %v", err, ErrNegativeRadicand)
+	}
+	if _, err := NewRadicalE(NewInteger(1), 4, 1); !errors.Is(err, ErrInvalidRootIndex) {
+		t.Errorf("NewRadicalE(index 1) error = %v, want %v", err, ErrInvalidRootIndex)
+	}
+}
+
+func TestRadical_AddSubtract(t *testing.T) {
+	got := NewSquareRoot(12).Add(NewSquareRoot(27), NewSquareRoot(3))
+	if got.String() != "6√3" {
+		t.Errorf("√12 + √27 + √3 = %s, want 6√3", got)
+	}
+
+	got = NewSquareRoot(50).Subtract(NewSquareRoot(8))
+	if got.String() != "3√2" {
+		t.Errorf("√50 - √8 = %s, want 3√2", got)
+	}
+
+	got = NewSquareRoot(2).Subtract(NewSquareRoot(2))
+	if !got.IsZero() || got.String() != "0" {
+		t.Errorf("√2 - √2 = %s, want 0", got)
+	}
+
+	if _, err := NewSquareRoot(2).TryAdd(NewSquareRoot(3)); !errors.Is(err, ErrUnlikeRadicals) {
+		t.Errorf("√2 + √3 error = %v, want %v", err, ErrUnlikeRadicals)
+	}
+}
+
+func TestRadical_MultiplyDivide(t *testing.T) {
+	tests := []struct {
+		name string
+		got  *Radical
+		want string
+	}{
+		{name: "Radical_Multiply_Square", got: NewSquareRoot(6).Multiply(NewSquareRoot(15)), want: "3√10"},
+		{name: "Radical_Multiply_Rational", got: NewSquareRoot(8).Multiply(NewSquareRoot(2)), want: "4"},
+		{name: "Radical_Multiply_MixedIndex", got: NewSquareRoot(2).Multiply(NewCubeRoot(2)), want: "⁶√32"},
+		{name: "Radical_Divide_Rationalize", got: NewRationalRadical(NewInteger(1)).Divide(NewSquareRoot(2)), want: "(1/2)√2"},
+		{name: "Radical_Divide_CubeRoot", got: NewRationalRadical(NewInteger(1)).Divide(NewRadical(NewInteger(2), 3, 3)), want: "(1/6)∛9"},
+		{name: "Radical_Divide_Like", got: NewSquareRoot(72).Divide(NewSquareRoot(2)), want: "6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("got %s, want %s", tt.got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewSquareRoot(2).TryDivide(NewSquareRoot(0)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("√2 / 0 error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestRationalizeDenominator(t *testing.T) {
+	rational, root, err := RationalizeDenominator(NewInteger(1), NewInteger(2), NewSquareRoot(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rational.String() != "2" || root.String() != "-√3" {
+		t.Errorf("1/(2 + √3) = %s + %s, want 2 + -√3", rational, root)
+	}
+
+	rational, root, err = RationalizeDenominator(NewInteger(4), NewInteger(3), NewSquareRoot(5).Multiply(NewRationalRadical(NewInteger(-1))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rational.String() != "3" || root.String() != "√5" {
+		t.Errorf("4/(3 - √5) = %s + %s, want 3 + √5", rational, root)
+	}
+
+	if _, _, err := RationalizeDenominator(NewInteger(1), NewInteger(1), NewCubeRoot(2)); err == nil {
+		t.Error("RationalizeDenominator() with a cube root should fail")
+	}
+}
+
+func TestRadical_Compare(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *Radical
+		want int
+	}{
+		{name: "Radical_Compare_Equal", a: NewSquareRoot(72), b: NewRadical(NewInteger(6), 2, 2), want: 0},
+		{name: "Radical_Compare_Less", a: NewRadical(NewInteger(2), 3, 2), b: NewRadical(NewInteger(3), 2, 2), want: -1},
+		{name: "Radical_Compare_MixedIndex", a: NewCubeRoot(3), b: NewSquareRoot(2), want: 1},
+		{name: "Radical_Compare_Negative", a: NewCubeRoot(-3), b: NewCubeRoot(-2), want: -1},
+		{name: "Radical_Compare_Sign", a: NewCubeRoot(-30), b: NewSquareRoot(2), want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Compare(tt.b); got != tt.want {
+				t.Errorf("%s.Compare(%s) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRadical_ToFloat64(t *testing.T) {
+	if got := NewSquareRoot(72).ToFloat64(); math.Abs(got-math.Sqrt(72)) > 1e-12 {
+		t.Errorf("√72 = %v, want %v", got, math.Sqrt(72))
+	}
+
+	if value, ok := NewSquareRoot(49).ToFraction(); !ok || value.String() != "7" {
+		t.Errorf("√49.ToFraction() = %v, %v", value, ok)
+	}
+	if _, ok := NewSquareRoot(2).ToFraction(); ok {
+		t.Error("√2 should not be rational")
+	}
+}
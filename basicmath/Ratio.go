@@ -0,0 +1,237 @@
+package basicmath
+
+import (
+	"fmt"
+	"strings"
+)
+
+// #region Constructor
+
+// Ratio compares two or more quantities, e.g. 3:4:5. Terms may be fractions until the ratio is simplified.
+type Ratio struct {
+	terms []*Fraction
+}
+
+// NewRatio builds a ratio from its terms in order; it panics with fewer than two terms, see NewRatioE.
+func NewRatio(terms ...*Fraction) *Ratio {
+	r, err := NewRatioE(terms...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func NewRatioE(terms ...*Fraction) (*Ratio, error) {
+	if len(terms) < 2 {
+		return nil, fmt.Errorf("basicmath: a ratio needs at least two terms, got %d", len(terms))
+	}
+
+	copies := make([]*Fraction, len(terms))
+	for i, term := range terms {
+		copies[i] = term.simplifiedCopy()
+	}
+
+	return &Ratio{terms: copies}, nil
+}
+
+// NewRatioFromInts builds a ratio of whole numbers, e.g. NewRatioFromInts(3, 4, 5) is 3:4:5
+func NewRatioFromInts(terms ...int) *Ratio {
+	fractions := make([]*Fraction, len(terms))
+	for i, term := range terms {
+		fractions[i] = NewInteger(term)
+	}
+	return NewRatio(fractions...)
+}
+
+// ParseRatio reads text such as "3:4:5", "1/2 : 3/4", "2.5 to 1" or "3∶4"; each term is read by ParseFraction
+func ParseRatio(s string) (*Ratio, error) {
+	text := strings.NewReplacer("∶", ":", " to ", ":").Replace(s)
+
+	parts := strings.Split(text, ":")
+	terms := make([]*Fraction, len(parts))
+	for i, part := range parts {
+		term, err := ParseFraction(part)
+		if err != nil {
+			return nil, fmt.Errorf("basicmath: invalid ratio %q: %w", s, err)
+		}
+		terms[i] = term
+	}
+
+	return NewRatioE(terms...)
+}
+
+// #endregion
+
+// #region Properties
+
+func (r *Ratio) Len() int {
+	return len(r.terms)
+}
+
+func (r *Ratio) Terms() []*Fraction {
+	terms := make([]*Fraction, len(r.terms))
+	for i, term := range r.terms {
+		terms[i] = term.simplifiedCopy()
+	}
+	return terms
+}
+
+// #endregion
+
+// #region LaTeXer
+
+// LaTeX joins the terms with colons, e.g. 3 : 4 : 5 or \dfrac{1}{2} : \dfrac{3}{4}
+func (r *Ratio) LaTeX() string {
+	parts := make([]string, len(r.terms))
+	for i, term := range r.terms {
+		parts[i] = term.LaTeX()
+	}
+	return strings.Join(parts, " : ")
+}
+
+// #endregion
+
+// #region Simplifiable
+
+// Simplify rewrites the ratio with whole-number terms that share no common factor,
+// e.g. 6:9:12 becomes 2:3:4 and 1/2 : 3/4 becomes 2:3. It panics with ErrOverflow.
+func (r *Ratio) Simplify() {
+	// clear the fractions by multiplying by the least common multiple of the denominators
+	denominators := make([]int, len(r.terms))
+	for i, term := range r.terms {
+		denominators[i] = term.d
+	}
+	scaled := r.Scale(NewInteger(LCM(denominators...)))
+
+	numerators := make([]int, len(r.terms))
+	for i, term := range scaled.terms {
+		numerators[i] = term.n
+	}
+	divisor := GCF(numerators...)
+	if divisor == 0 {
+		r.terms = scaled.terms
+		return
+	}
+
+	for i, n := range numerators {
+		r.terms[i] = NewInteger(n / divisor)
+	}
+}
+
+// #endregion
+
+// #region Stringer
+
+func (r *Ratio) String() string {
+	parts := make([]string, len(r.terms))
+	for i, term := range r.terms {
+		parts[i] = term.String()
+	}
+	return strings.Join(parts, ":")
+}
+
+// #endregion
+
+// #region Public Methods
+
+// Equals reports whether the ratios have exactly the same terms, so 2:3 does not equal 4:6; see IsEquivalent.
+func (r *Ratio) Equals(other *Ratio) bool {
+	if len(r.terms) != len(other.terms) {
+		return false
+	}
+	for i, term := range r.terms {
+		if !term.Equals(other.terms[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEquivalent reports whether the ratios simplify to the same ratio, e.g. 2:3 and 4:6
+func (r *Ratio) IsEquivalent(other *Ratio) bool {
+	a, b := r.copy(), other.copy()
+	a.Simplify()
+	b.Simplify()
+	return a.Equals(b)
+}
+
+// Scale multiplies every term by factor, e.g. 2:3 scaled by 5 is 10:15. It panics with ErrOverflow.
+func (r *Ratio) Scale(factor *Fraction) *Ratio {
+	terms := make([]*Fraction, len(r.terms))
+	for i, term := range r.terms {
+		terms[i] = term.Multiply(factor).simplifiedCopy()
+	}
+	return &Ratio{terms: terms}
+}
+
+// ScaleToTotal shares total in the ratio, e.g. 50 shared in the ratio 2:3 is 20 and 30
+func (r *Ratio) ScaleToTotal(total *Fraction) ([]*Fraction, error) {
+	sum, err := NewInteger(0).TryAdd(r.terms...)
+	if err != nil {
+		return nil, err
+	}
+	if sum.n == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	factor, err := total.TryDivide(sum)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]*Fraction, len(r.terms))
+	for i, term := range r.terms {
+		share, err := term.TryMultiply(factor)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = share.simplifiedCopy()
+	}
+	return shares, nil
+}
+
+// UnitRate divides the first term of a two-term ratio by the second, e.g. 12 dollars : 4 items is 3 dollars per item
+func (r *Ratio) UnitRate() (*Fraction, error) {
+	if len(r.terms) != 2 {
+		return nil, fmt.Errorf("basicmath: a unit rate needs a ratio of two terms, got %d", len(r.terms))
+	}
+	if r.terms[1].n == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	rate, err := r.terms[0].TryDivide(r.terms[1])
+	if err != nil {
+		return nil, err
+	}
+	return rate.simplifiedCopy(), nil
+}
+
+// ToUnitRatio scales the ratio so the term at index is 1, e.g. 6:4:2 per the third term is 3:2:1
+func (r *Ratio) ToUnitRatio(index int) (*Ratio, error) {
+	if index < 0 || index >= len(r.terms) {
+		return nil, fmt.Errorf("basicmath: term index %d is outside a ratio of %d terms", index, len(r.terms))
+	}
+	if r.terms[index].n == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	terms := make([]*Fraction, len(r.terms))
+	for i, term := range r.terms {
+		scaled, err := term.TryDivide(r.terms[index])
+		if err != nil {
+			return nil, err
+		}
+		terms[i] = scaled.simplifiedCopy()
+	}
+	return &Ratio{terms: terms}, nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+func (r *Ratio) copy() *Ratio {
+	return &Ratio{terms: r.Terms()}
+}
+
+// #endregion
@@ -0,0 +1,119 @@
+package basicmath
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRatio(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      *Ratio
+		wantLaTeX string
+	}{
+		{"ParseRatio_Three", "3:4:5", NewRatioFromInts(3, 4, 5), "3 : 4 : 5"},
+		{"ParseRatio_Fractions", "1/2 : 3/4", NewRatio(NewFraction(1, 2), NewFraction(3, 4)), `\dfrac{1}{2} : \dfrac{3}{4}`},
+		{"ParseRatio_Words", "2.5 to 1", NewRatio(NewFraction(5, 2), NewInteger(1)), `\dfrac{5}{2} : 1`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRatio(tt.input)
+			if err != nil {
+				t.Fatalf("ParseRatio() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("ParseRatio() = %v, want %v", got, tt.want)
+			}
+			if got.LaTeX() != tt.wantLaTeX {
+				t.Errorf("LaTeX() = %v, want %v", got.LaTeX(), tt.wantLaTeX)
+			}
+		})
+	}
+
+	for _, input := range []string{"3", "3:x", ""} {
+		if _, err := ParseRatio(input); err == nil {
+			t.Errorf("ParseRatio(%q) should fail", input)
+		}
+	}
+}
+
+func TestRatio_Simplify(t *testing.T) {
+	tests := []struct {
+		name  string
+		ratio *Ratio
+		want  string
+	}{
+		{"Ratio_Simplify_Integers", NewRatioFromInts(6, 9, 12), "2:3:4"},
+		{"Ratio_Simplify_Fractions", NewRatio(NewFraction(1, 2), NewFraction(3, 4)), "2:3"},
+		{"Ratio_Simplify_Mixed", NewRatio(NewFraction(5, 2), NewInteger(1)), "5:2"},
+		{"Ratio_Simplify_AlreadySimple", NewRatioFromInts(3, 5), "3:5"},
+		{"Ratio_Simplify_Zero", NewRatioFromInts(0, 4), "0:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.ratio.Simplify()
+			if got := tt.ratio.String(); got != tt.want {
+				t.Errorf("Simplify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRatio_IsEquivalent(t *testing.T) {
+	if !NewRatioFromInts(2, 3).IsEquivalent(NewRatioFromInts(4, 6)) {
+		t.Errorf("2:3 should be equivalent to 4:6")
+	}
+	if NewRatioFromInts(2, 3).Equals(NewRatioFromInts(4, 6)) {
+		t.Errorf("2:3 should not equal 4:6")
+	}
+	if NewRatioFromInts(2, 3).IsEquivalent(NewRatioFromInts(3, 2)) {
+		t.Errorf("2:3 should not be equivalent to 3:2")
+	}
+}
+
+func TestRatio_ScaleToTotal(t *testing.T) {
+	tests := []struct {
+		name  string
+		ratio *Ratio
+		total *Fraction
+		want  []*Fraction
+	}{
+		{"Ratio_ScaleToTotal_Two", NewRatioFromInts(2, 3), NewInteger(50), []*Fraction{NewInteger(20), NewInteger(30)}},
+		{"Ratio_ScaleToTotal_Three", NewRatioFromInts(1, 2, 3), NewInteger(9), []*Fraction{NewFraction(3, 2), NewInteger(3), NewFraction(9, 2)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.ratio.ScaleToTotal(tt.total)
+			if err != nil {
+				t.Fatalf("ScaleToTotal() error = %v", err)
+			}
+			for i := range tt.want {
+				if !got[i].Equals(tt.want[i]) {
+					t.Errorf("ScaleToTotal()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+
+	if _, err := NewRatioFromInts(1, -1).ScaleToTotal(NewInteger(5)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("ScaleToTotal() error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestRatio_UnitRate(t *testing.T) {
+	if got, err := NewRatioFromInts(12, 4).UnitRate(); err != nil || !got.Equals(NewInteger(3)) {
+		t.Errorf("UnitRate() = %v, %v, want 3", got, err)
+	}
+	if got, err := NewRatio(NewFraction(3, 2), NewFraction(3, 4)).UnitRate(); err != nil || !got.Equals(NewInteger(2)) {
+		t.Errorf("UnitRate() = %v, %v, want 2", got, err)
+	}
+	if _, err := NewRatioFromInts(1, 2, 3).UnitRate(); err == nil {
+		t.Errorf("UnitRate() should fail for three terms")
+	}
+
+	got, err := NewRatioFromInts(6, 4, 2).ToUnitRatio(2)
+	if err != nil || got.String() != "3:2:1" {
+		t.Errorf("ToUnitRatio() = %v, %v, want 3:2:1", got, err)
+	}
+}
@@ -0,0 +1,127 @@
+package basicmath
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"mymath/interfaces"
+)
+
+// #region Constructor
+
+// Rational is a value that is either a *Fraction or a *BigFraction. The Rational functions below accept
+// either kind and return a *Fraction while the result fits in an int, moving to a *BigFraction only when
+// it does not, so values such as algebra.Monomial coefficients never overflow.
+type Rational interface {
+	fmt.Stringer
+	interfaces.LaTeXer
+	interfaces.Simplifiable
+	IsInteger() bool
+	ToBigFraction() *BigFraction
+}
+
+var (
+	_ Rational = (*Fraction)(nil)
+	_ Rational = (*BigFraction)(nil)
+)
+
+// #endregion
+
+// #region Public Methods
+
+// AddRationals returns a + b
+func AddRationals(a, b Rational) Rational {
+	if x, y, ok := bothFractions(a, b); ok {
+		if sum, err := x.TryAdd(y); !errors.Is(err, ErrOverflow) {
+			return mustFraction(sum, err)
+		}
+	}
+
+	return smallestRational(a.ToBigFraction().Add(b.ToBigFraction()))
+}
+
+// SubtractRationals returns a - b
+func SubtractRationals(a, b Rational) Rational {
+	if x, y, ok := bothFractions(a, b); ok {
+		if difference, err := x.TrySubtract(y); !errors.Is(err, ErrOverflow) {
+			return mustFraction(difference, err)
+		}
+	}
+
+	return smallestRational(a.ToBigFraction().Subtract(b.ToBigFraction()))
+}
+
+// MultiplyRationals returns a × b
+func MultiplyRationals(a, b Rational) Rational {
+	if x, y, ok := bothFractions(a, b); ok {
+		if product, err := x.TryMultiply(y); !errors.Is(err, ErrOverflow) {
+			return mustFraction(product, err)
+		}
+	}
+
+	return smallestRational(a.ToBigFraction().Multiply(b.ToBigFraction()))
+}
+
+// DivideRationals returns a ÷ b; it panics with ErrDivisionByZero when b is zero
+func DivideRationals(a, b Rational) Rational {
+	if x, y, ok := bothFractions(a, b); ok {
+		if quotient, err := x.TryDivide(y); !errors.Is(err, ErrOverflow) {
+			return mustFraction(quotient, err)
+		}
+	}
+
+	return smallestRational(a.ToBigFraction().Divide(b.ToBigFraction()))
+}
+
+// CompareRationals returns -1 if a < b, 0 if a = b and 1 if a > b
+func CompareRationals(a, b Rational) int {
+	if x, y, ok := bothFractions(a, b); ok {
+		return x.Compare(y)
+	}
+
+	return a.ToBigFraction().Compare(b.ToBigFraction())
+}
+
+// GetRationalGCF works like GetFractionGCF, taking the GCF of the numerators over the GCF of the denominators
+func GetRationalGCF(rationals ...Rational) Rational {
+	if len(rationals) == 0 {
+		return NewInteger(0)
+	}
+
+	gcf := rationals[0]
+	for _, rational := range rationals[1:] {
+		if x, y, ok := bothFractions(gcf, rational); ok {
+			gcf = getGCFofTwoFractions(x, y)
+			continue
+		}
+
+		a, b := gcf.ToBigFraction(), rational.ToBigFraction()
+		numerator := new(big.Int).GCD(nil, nil, a.r.Num(), b.r.Num())
+		denominator := new(big.Int).GCD(nil, nil, a.r.Denom(), b.r.Denom())
+		gcf = smallestRational(NewBigFractionFromBigInts(numerator, denominator))
+	}
+
+	return gcf
+}
+
+// #endregion
+
+// #region Private Methods
+
+func bothFractions(a, b Rational) (*Fraction, *Fraction, bool) {
+	x, xOk := a.(*Fraction)
+	y, yOk := b.(*Fraction)
+
+	return x, y, xOk && yOk
+}
+
+// returns f as a *Fraction when it fits in one
+func smallestRational(f *BigFraction) Rational {
+	if small, ok := f.ToFraction(); ok {
+		return small
+	}
+
+	return f
+}
+
+// #endregion
@@ -0,0 +1,58 @@
+package basicmath
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRationals_Operations(t *testing.T) {
+	huge := NewInteger(math.MaxInt)
+
+	tests := []struct {
+		name      string
+		got       Rational
+		want      string
+		wantSmall bool
+	}{
+		{name: "Rationals_Add_Small", got: AddRationals(NewFraction(1, 2), NewFraction(1, 3)), want: "5/6", wantSmall: true},
+		{name: "Rationals_Add_Promotes", got: AddRationals(huge, huge), want: "18446744073709551614"},
+		{name: "Rationals_Subtract_Demotes", got: SubtractRationals(AddRationals(huge, NewInteger(1)), NewInteger(2)), want: "9223372036854775806", wantSmall: true},
+		{name: "Rationals_Multiply_Promotes", got: MultiplyRationals(huge, NewFraction(-3, 2)), want: "-27670116110564327421/2"},
+		{name: "Rationals_Divide_Promotes", got: DivideRationals(NewInteger(1), MultiplyRationals(huge, huge)), want: "1/85070591730234615847396907784232501249"},
+		{name: "Rationals_Divide_Mixed", got: DivideRationals(NewBigFraction(3, 4), NewFraction(1, 2)), want: "3/2", wantSmall: true},
+		{name: "Rationals_GCF_Small", got: GetRationalGCF(NewInteger(12), NewInteger(18)), want: "6", wantSmall: true},
+		{name: "Rationals_GCF_Big", got: GetRationalGCF(MultiplyRationals(huge, NewInteger(4)), NewInteger(6)), want: "2", wantSmall: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("got %s, want %s", tt.got, tt.want)
+			}
+			if _, small := tt.got.(*Fraction); small != tt.wantSmall {
+				t.Errorf("%s is a *Fraction: %v, want %v", tt.got, small, tt.wantSmall)
+			}
+		})
+	}
+}
+
+func TestCompareRationals(t *testing.T) {
+	promoted := AddRationals(NewInteger(math.MaxInt), NewInteger(1))
+
+	if CompareRationals(NewFraction(1, 2), NewBigFraction(2, 4)) != 0 {
+		t.Error("1/2 and the BigFraction 2/4 should be equal")
+	}
+	if CompareRationals(promoted, NewInteger(math.MaxInt)) <= 0 || CompareRationals(NewInteger(math.MaxInt), promoted) >= 0 {
+		t.Errorf("%s should be greater than %d", promoted, math.MaxInt)
+	}
+}
+
+func TestDivideRationals_ByZero(t *testing.T) {
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrDivisionByZero) {
+			t.Errorf("DivideRationals() by zero panicked with %v, want %v", err, ErrDivisionByZero)
+		}
+	}()
+
+	DivideRationals(NewInteger(1), NewInteger(0))
+}
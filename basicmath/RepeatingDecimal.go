@@ -0,0 +1,323 @@
+package basicmath
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// MaxRepeatingDigits bounds how many digits are generated while searching for a repeating block
+const MaxRepeatingDigits = 100000
+
+// #region Constructor
+
+// RepeatingDecimal is the decimal expansion of a fraction, split into an integer part,
+// the digits that do not repeat, and the repeating block (empty when the decimal terminates).
+// For example 1/6 = 0.1(6) has integer part 0, non-repeating digits "1" and repeating block "6".
+type RepeatingDecimal struct {
+	negative     bool
+	integer      int
+	nonRepeating string
+	repeating    string
+}
+
+// ToRepeatingDecimal expands the fraction by long division, detecting the repeating block;
+// the zero value Fraction{} is ErrZeroDenominator
+func (f *Fraction) ToRepeatingDecimal() (*RepeatingDecimal, error) {
+	value, err := f.checkedCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	integer, prefix, cycle, err := expandFraction(value.n, value.d, 10)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RepeatingDecimal{
+		negative:     value.n < 0,
+		integer:      integer,
+		nonRepeating: digitsToString(prefix),
+		repeating:    digitsToString(cycle),
+	}, nil
+}
+
+// #endregion
+
+// #region Properties
+
+// IntegerPart returns the absolute value of the whole-number part
+func (r *RepeatingDecimal) IntegerPart() int {
+	return r.integer
+}
+
+// IsNegative reports whether the value is below zero
+func (r *RepeatingDecimal) IsNegative() bool {
+	return r.negative
+}
+
+// NonRepeating returns the digits after the decimal point that come before the repeating block
+func (r *RepeatingDecimal) NonRepeating() string {
+	return r.nonRepeating
+}
+
+// Repeating returns the repeating block of digits, or "" when the decimal terminates
+func (r *RepeatingDecimal) Repeating() string {
+	return r.repeating
+}
+
+// Period returns the length of the repeating block
+func (r *RepeatingDecimal) Period() int {
+	return len(r.repeating)
+}
+
+// #endregion
+
+// #region LaTeXer
+
+func (r *RepeatingDecimal) LaTeX() string {
+	var sb strings.Builder
+
+	sb.WriteString(r.sign())
+	sb.WriteString(strconv.Itoa(r.integer))
+
+	if r.nonRepeating != "" || r.repeating != "" {
+		sb.WriteString(".")
+		sb.WriteString(r.nonRepeating)
+		if r.repeating != "" {
+			sb.WriteString(fmt.Sprintf(`\overline{%s}`, r.repeating))
+		}
+	}
+
+	return sb.String()
+}
+
+// #endregion
+
+// #region Stringer
+
+// String uses parentheses around the repeating block, e.g. 0.1(6), which ParseRepeatingDecimal reads back
+func (r *RepeatingDecimal) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(r.sign())
+	sb.WriteString(strconv.Itoa(r.integer))
+
+	if r.nonRepeating != "" || r.repeating != "" {
+		sb.WriteString(".")
+		sb.WriteString(r.nonRepeating)
+		if r.repeating != "" {
+			sb.WriteString(fmt.Sprintf("(%s)", r.repeating))
+		}
+	}
+
+	return sb.String()
+}
+
+// #endregion
+
+// #region Public Methods
+
+// IsTerminating reports whether the decimal ends (has no repeating block)
+func (r *RepeatingDecimal) IsTerminating() bool {
+	return r.repeating == ""
+}
+
+// ToFraction converts the expansion back to an exact fraction
+func (r *RepeatingDecimal) ToFraction() (*Fraction, error) {
+	return decimalPartsToFraction(r.negative, strconv.Itoa(r.integer), r.nonRepeating, r.repeating, 10)
+}
+
+// ParseRepeatingDecimal reads a decimal such as "0.25", "0.1(6)", "-2.(3)", "0.1\overline{6}" or "0.1666..."
+// into an exact fraction. With a trailing ellipsis the shortest block that repeats at least twice is used.
+func ParseRepeatingDecimal(s string) (*Fraction, error) {
+	text := strings.TrimSpace(s)
+	negative := false
+
+	if strings.HasPrefix(text, "-") || strings.HasPrefix(text, "−") {
+		negative = true
+		text = strings.TrimPrefix(strings.TrimPrefix(text, "-"), "−")
+	} else {
+		text = strings.TrimPrefix(text, "+")
+	}
+
+	integer, fractional, _ := strings.Cut(text, ".")
+	if !isDigits(integer, 10) {
+		return nil, fmt.Errorf("basicmath: invalid repeating decimal %q", s)
+	}
+
+	var nonRepeating, repeating string
+
+	switch {
+	case strings.HasSuffix(fractional, "...") || strings.HasSuffix(fractional, "…"):
+		digits := strings.TrimSuffix(strings.TrimSuffix(fractional, "..."), "…")
+		if !isDigits(digits, 10) {
+			return nil, fmt.Errorf("basicmath: invalid repeating decimal %q", s)
+		}
+
+		var ok bool
+		nonRepeating, repeating, ok = inferRepeatingBlock(digits)
+		if !ok {
+			return nil, fmt.Errorf("basicmath: cannot find a repeating block in %q", s)
+		}
+	case strings.Contains(fractional, `\overline{`):
+		before, after, _ := strings.Cut(fractional, `\overline{`)
+		block, rest, found := strings.Cut(after, "}")
+		if !found || rest != "" || block == "" {
+			return nil, fmt.Errorf("basicmath: invalid repeating decimal %q", s)
+		}
+		nonRepeating, repeating = before, block
+	case strings.Contains(fractional, "("):
+		before, after, _ := strings.Cut(fractional, "(")
+		block, rest, found := strings.Cut(after, ")")
+		if !found || rest != "" || block == "" {
+			return nil, fmt.Errorf("basicmath: invalid repeating decimal %q", s)
+		}
+		nonRepeating, repeating = before, block
+	default:
+		nonRepeating = fractional
+	}
+
+	if !isDigits(nonRepeating, 10) || !isDigits(repeating, 10) {
+		return nil, fmt.Errorf("basicmath: invalid repeating decimal %q", s)
+	}
+	if integer == "" && nonRepeating == "" && repeating == "" {
+		return nil, fmt.Errorf("basicmath: invalid repeating decimal %q: no digits", s)
+	}
+	if integer == "" {
+		integer = "0"
+	}
+
+	return decimalPartsToFraction(negative, integer, nonRepeating, repeating, 10)
+}
+
+// #endregion
+
+// #region Private Methods
+
+func (r *RepeatingDecimal) sign() string {
+	if r.negative {
+		return "-"
+	}
+	return ""
+}
+
+func digitsToString(digits []int) string {
+	var sb strings.Builder
+	for _, digit := range digits {
+		sb.WriteString(strconv.FormatInt(int64(digit), 36))
+	}
+	return strings.ToUpper(sb.String())
+}
+
+// builds sign * (integer.nonRepeating(repeating)) written in base as an exact fraction
+func decimalPartsToFraction(negative bool, integer, nonRepeating, repeating string, base int) (*Fraction, error) {
+	result, err := decimalPartsToRat(negative, integer, nonRepeating, repeating, base)
+	if err != nil {
+		return nil, err
+	}
+
+	return ratToFraction(result)
+}
+
+// the exact value of the digits as a big.Rat, which never overflows however long the cycle is
+func decimalPartsToRat(negative bool, integer, nonRepeating, repeating string, base int) (*big.Rat, error) {
+	value, ok := new(big.Int).SetString(integer, base)
+	if !ok {
+		return nil, fmt.Errorf("basicmath: invalid digits %q for base %d", integer, base)
+	}
+	result := new(big.Rat).SetInt(value)
+
+	b := big.NewInt(int64(base))
+	shift := new(big.Int).Exp(b, big.NewInt(int64(len(nonRepeating))), nil)
+
+	if nonRepeating != "" {
+		prefix, _ := new(big.Int).SetString(nonRepeating, base)
+		result.Add(result, new(big.Rat).SetFrac(prefix, shift))
+	}
+
+	if repeating != "" {
+		cycle, _ := new(big.Int).SetString(repeating, base)
+		nines := new(big.Int).Exp(b, big.NewInt(int64(len(repeating))), nil)
+		nines.Sub(nines, big.NewInt(1))
+		result.Add(result, new(big.Rat).SetFrac(cycle, new(big.Int).Mul(nines, shift)))
+	}
+
+	if negative {
+		result.Neg(result)
+	}
+
+	return result, nil
+}
+
+// expands |n|/d (d > 0) in base, returning the integer part, the digits before the cycle and the repeating cycle
+func expandFraction(n, d, base int) (integer int, prefix []int, cycle []int, err error) {
+	if n == math.MinInt {
+		return 0, nil, nil, ErrOverflow
+	}
+
+	n = Abs(n)
+	integer = n / d
+	remainder := n % d
+
+	digits := []int{}
+	seen := make(map[int]int)
+
+	for remainder != 0 {
+		if start, exists := seen[remainder]; exists {
+			return integer, digits[:start], digits[start:], nil
+		}
+		if len(digits) >= MaxRepeatingDigits {
+			return 0, nil, nil, fmt.Errorf("basicmath: expansion of %d/%d needs more than %d digits", n, d, MaxRepeatingDigits)
+		}
+
+		seen[remainder] = len(digits)
+
+		var digit int
+		digit, remainder = nextDigit(remainder, d, base)
+		digits = append(digits, digit)
+	}
+
+	return integer, digits, nil, nil
+}
+
+// one step of long division in base: remainder*base = digit*d + next
+func nextDigit(remainder, d, base int) (digit int, next int) {
+	// remainder*base can overflow an int for large denominators, so divide the 128-bit product
+	hi, lo := bits.Mul64(uint64(remainder), uint64(base))
+	quotient, rem := bits.Div64(hi, lo, uint64(d))
+	return int(quotient), int(rem)
+}
+
+// finds the shortest block that repeats at the end of digits, extended as far left as it keeps repeating
+func inferRepeatingBlock(digits string) (nonRepeating string, repeating string, ok bool) {
+	for period := 1; 2*period <= len(digits); period++ {
+		if digits[len(digits)-period:] != digits[len(digits)-2*period:len(digits)-period] {
+			continue
+		}
+
+		start := len(digits) - 2*period
+		for start > 0 && digits[start-1] == digits[start-1+period] {
+			start--
+		}
+
+		return digits[:start], digits[start : start+period], true
+	}
+
+	return "", "", false
+}
+
+func isDigits(s string, base int) bool {
+	for _, char := range s {
+		value, err := strconv.ParseInt(string(char), base, 64)
+		if err != nil || value < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// #endregion
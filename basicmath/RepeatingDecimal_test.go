@@ -0,0 +1,116 @@
+package basicmath
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFraction_ToRepeatingDecimal(t *testing.T) {
+	tests := []struct {
+		name      string
+		f         *Fraction
+		wantText  string
+		wantLaTeX string
+	}{
+		{
+			name:      "RepeatingDecimal_Test01",
+			f:         NewFraction(1, 7),
+			wantText:  "0.(142857)",
+			wantLaTeX: `0.\overline{142857}`,
+		},
+		{
+			name:      "RepeatingDecimal_Test02",
+			f:         NewFraction(1, 6),
+			wantText:  "0.1(6)",
+			wantLaTeX: `0.1\overline{6}`,
+		},
+		{
+			name:      "RepeatingDecimal_Test03",
+			f:         NewFraction(-7, 3),
+			wantText:  "-2.(3)",
+			wantLaTeX: `-2.\overline{3}`,
+		},
+		{
+			name:      "RepeatingDecimal_Test04",
+			f:         NewFraction(5, 4),
+			wantText:  "1.25",
+			wantLaTeX: `1.25`,
+		},
+		{
+			name:      "RepeatingDecimal_Test05",
+			f:         NewInteger(3),
+			wantText:  "3",
+			wantLaTeX: `3`,
+		},
+		{
+			name:      "RepeatingDecimal_Test06",
+			f:         NewFraction(7, 12),
+			wantText:  "0.58(3)",
+			wantLaTeX: `0.58\overline{3}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f.ToRepeatingDecimal()
+			if err != nil {
+				t.Fatalf("Fraction.ToRepeatingDecimal() error = %v", err)
+			}
+			if got.String() != tt.wantText {
+				t.Errorf("RepeatingDecimal.String() = %v, want %v", got.String(), tt.wantText)
+			}
+			if got.LaTeX() != tt.wantLaTeX {
+				t.Errorf("RepeatingDecimal.LaTeX() = %v, want %v", got.LaTeX(), tt.wantLaTeX)
+			}
+
+			back, err := got.ToFraction()
+			if err != nil || !back.Equals(tt.f) {
+				t.Errorf("RepeatingDecimal.ToFraction() = %v, %v, want %v", back, err, tt.f)
+			}
+		})
+	}
+
+	if _, err := (&Fraction{}).ToRepeatingDecimal(); !errors.Is(err, ErrZeroDenominator) {
+		t.Errorf("Fraction{}.ToRepeatingDecimal() error = %v, want %v", err, ErrZeroDenominator)
+	}
+}
+
+func TestParseRepeatingDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    *Fraction
+		wantErr bool
+	}{
+		{name: "ParseRepeatingDecimal_Parentheses", s: "0.1(6)", want: NewFraction(1, 6)},
+		{name: "ParseRepeatingDecimal_Ellipsis", s: "0.1666...", want: NewFraction(1, 6)},
+		{name: "ParseRepeatingDecimal_LongEllipsis", s: "0.142857142857...", want: NewFraction(1, 7)},
+		{name: "ParseRepeatingDecimal_Overline", s: `0.58\overline{3}`, want: NewFraction(7, 12)},
+		{name: "ParseRepeatingDecimal_Negative", s: "-2.(3)", want: NewFraction(-7, 3)},
+		{name: "ParseRepeatingDecimal_Terminating", s: "1.25", want: NewFraction(5, 4)},
+		{name: "ParseRepeatingDecimal_NoLeadingZero", s: ".(9)", want: NewInteger(1)},
+		{name: "ParseRepeatingDecimal_Integer", s: "12", want: NewInteger(12)},
+		{name: "ParseRepeatingDecimal_NoBlock", s: "0.12...", wantErr: true},
+		{name: "ParseRepeatingDecimal_Unclosed", s: "0.1(6", wantErr: true},
+		{name: "ParseRepeatingDecimal_Letters", s: "0.1a", wantErr: true},
+		{name: "ParseRepeatingDecimal_Empty", s: "", wantErr: true},
+		{name: "ParseRepeatingDecimal_SignOnly", s: "-", wantErr: true},
+		{name: "ParseRepeatingDecimal_PointOnly", s: ".", wantErr: true},
+		{name: "ParseRepeatingDecimal_SignAndPoint", s: "-.", wantErr: true},
+		{name: "ParseRepeatingDecimal_EmptyOverline", s: `0.1\overline{}`, wantErr: true},
+		{name: "ParseRepeatingDecimal_EmptyParentheses", s: "0.1()", wantErr: true},
+		{name: "ParseRepeatingDecimal_TrailingPoint", s: "5.", want: NewInteger(5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRepeatingDecimal(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRepeatingDecimal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRepeatingDecimal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
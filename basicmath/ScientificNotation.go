@@ -0,0 +1,37 @@
+package basicmath
+
+import "fmt"
+
+// ScientificNotation is a number written as Coefficient × 10^Exponent with 1 ≤ |Coefficient| < 10,
+// or a zero coefficient for zero; see Decimal.ToScientific
+type ScientificNotation struct {
+	Coefficient *Decimal
+	Exponent    int
+}
+
+// #region LaTeXer
+
+// LaTeX renders the number as e.g. 6.02 \times 10^{23}
+func (s *ScientificNotation) LaTeX() string {
+	return fmt.Sprintf(`%s \times 10^{%d}`, s.Coefficient.LaTeX(), s.Exponent)
+}
+
+// #endregion
+
+// #region Stringer
+
+// String renders the number as e.g. 6.02 × 10^23
+func (s *ScientificNotation) String() string {
+	return fmt.Sprintf("%s × 10^%d", s.Coefficient, s.Exponent)
+}
+
+// #endregion
+
+// #region Public Methods
+
+// ToDecimal moves the decimal point back, e.g. 1.20 × 10^-3 is 0.00120
+func (s *ScientificNotation) ToDecimal() (*Decimal, error) {
+	return NewDecimalE(s.Coefficient.unscaled, s.Coefficient.scale-s.Exponent)
+}
+
+// #endregion
@@ -0,0 +1,84 @@
+package basicmath
+
+import (
+	"fmt"
+	"mymath/latex"
+	"strings"
+)
+
+// Step is one line of a worked solution: what was done and the LaTeX it produced
+type Step struct {
+	Description string
+	Expression  string
+}
+
+// StepLog collects the steps of a worked solution in order.
+// A nil *StepLog is valid and ignores everything added to it.
+type StepLog struct {
+	steps []Step
+}
+
+// #region Public Methods
+
+// Add records a step; expression is LaTeX math
+func (l *StepLog) Add(description string, expression string) {
+	if l == nil {
+		return
+	}
+
+	l.steps = append(l.steps, Step{Description: description, Expression: expression})
+}
+
+// Append copies every step of other onto the end of the log
+func (l *StepLog) Append(other *StepLog) {
+	if l == nil || other == nil {
+		return
+	}
+
+	l.steps = append(l.steps, other.steps...)
+}
+
+func (l *StepLog) Len() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.steps)
+}
+
+func (l *StepLog) Steps() []Step {
+	if l == nil {
+		return nil
+	}
+
+	steps := make([]Step, len(l.steps))
+	copy(steps, l.steps)
+	return steps
+}
+
+// LaTeX renders the steps as align* lines with the descriptions as trailing text
+func (l *StepLog) LaTeX() string {
+	lines := []string{}
+	for _, step := range l.Steps() {
+		if step.Description == "" {
+			lines = append(lines, fmt.Sprintf("& %s", step.Expression))
+		} else {
+			lines = append(lines, fmt.Sprintf("& %s && %s", step.Expression, latex.Text(step.Description)))
+		}
+	}
+
+	return latex.Align(lines...)
+}
+
+func (l *StepLog) String() string {
+	var sb strings.Builder
+	for i, step := range l.Steps() {
+		if step.Description == "" {
+			sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, step.Expression))
+		} else {
+			sb.WriteString(fmt.Sprintf("%d. %s: %s\n", i+1, step.Description, step.Expression))
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// #endregion
@@ -0,0 +1,36 @@
+package basicmath
+
+import "testing"
+
+func TestStepLog(t *testing.T) {
+	log := &StepLog{}
+	log.Add("divide", `7 = 2 \cdot 3 + 1`)
+	log.Add("", `x = 1`)
+
+	wantLaTeX := "\\begin{align*}\n& 7 = 2 \\cdot 3 + 1 && \\text{divide} \\\\\n& x = 1\n\\end{align*}"
+	if got := log.LaTeX(); got != wantLaTeX {
+		t.Errorf("StepLog.LaTeX() = %q, want %q", got, wantLaTeX)
+	}
+
+	wantString := "1. divide: 7 = 2 \\cdot 3 + 1\n2. x = 1"
+	if got := log.String(); got != wantString {
+		t.Errorf("StepLog.String() = %q, want %q", got, wantString)
+	}
+
+	other := &StepLog{}
+	other.Add("done", "1")
+	log.Append(other)
+	if log.Len() != 3 {
+		t.Errorf("StepLog.Len() = %d, want 3", log.Len())
+	}
+}
+
+func TestStepLog_Nil(t *testing.T) {
+	var log *StepLog
+	log.Add("ignored", "x")
+	log.Append(&StepLog{})
+
+	if log.Len() != 0 || log.Steps() != nil {
+		t.Error("a nil StepLog should stay empty")
+	}
+}
@@ -0,0 +1,111 @@
+package stats
+
+import (
+	"mymath/basicmath"
+	"sort"
+)
+
+// #region Public Methods
+
+// Mean returns the exact arithmetic mean
+func Mean(data []*basicmath.Fraction) (*basicmath.Fraction, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyData
+	}
+
+	sum, err := Sum(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return sum.TryDivide(basicmath.NewInteger(len(data)))
+}
+
+// Median returns the middle value, or the mean of the two middle values for an even count
+func Median(data []*basicmath.Fraction) (*basicmath.Fraction, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyData
+	}
+
+	return median(sorted(data))
+}
+
+// Mode returns every value that occurs most often, in ascending order.
+// When every value occurs equally often there is no mode and the result is empty.
+func Mode(data []*basicmath.Fraction) ([]*basicmath.Fraction, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyData
+	}
+
+	table := NewFrequencyTable(data)
+
+	highest := 0
+	for _, row := range table {
+		highest = basicmath.Max(highest, row.Frequency)
+	}
+
+	modes := []*basicmath.Fraction{}
+	if len(table) > 1 && allFrequenciesEqual(table) {
+		return modes, nil
+	}
+
+	for _, row := range table {
+		if row.Frequency == highest {
+			modes = append(modes, row.Value)
+		}
+	}
+
+	return modes, nil
+}
+
+// Sum adds every value, returning basicmath.ErrOverflow if the total does not fit
+func Sum(data []*basicmath.Fraction) (*basicmath.Fraction, error) {
+	return basicmath.NewInteger(0).TryAdd(data...)
+}
+
+// #endregion
+
+// #region Private Methods
+
+func allFrequenciesEqual(table FrequencyTable) bool {
+	for _, row := range table[1:] {
+		if row.Frequency != table[0].Frequency {
+			return false
+		}
+	}
+	return true
+}
+
+// median of data that is already sorted
+func median(data []*basicmath.Fraction) (*basicmath.Fraction, error) {
+	middle := len(data) / 2
+	if len(data)%2 == 1 {
+		return copyOf(data[middle]), nil
+	}
+
+	sum, err := data[middle-1].TryAdd(data[middle])
+	if err != nil {
+		return nil, err
+	}
+
+	return sum.TryDivide(basicmath.NewInteger(2))
+}
+
+// returns a new fraction with the same value so callers cannot change the data through a result
+func copyOf(value *basicmath.Fraction) *basicmath.Fraction {
+	return basicmath.NewFraction(value.Numerator(), value.Denominator())
+}
+
+// returns a sorted copy so the caller's slice keeps its order
+func sorted(data []*basicmath.Fraction) []*basicmath.Fraction {
+	values := make([]*basicmath.Fraction, len(data))
+	copy(values, data)
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].LessThan(values[j])
+	})
+
+	return values
+}
+
+// #endregion
@@ -0,0 +1,109 @@
+package stats
+
+import (
+	"errors"
+	"mymath/basicmath"
+	"testing"
+)
+
+func fractions(values ...string) []*basicmath.Fraction {
+	data := make([]*basicmath.Fraction, len(values))
+	for i, value := range values {
+		f, err := basicmath.ParseFraction(value)
+		if err != nil {
+			panic(err)
+		}
+		data[i] = f
+	}
+	return data
+}
+
+func TestMean(t *testing.T) {
+	tests := []struct {
+		name string
+		data []*basicmath.Fraction
+		want string
+	}{
+		{name: "Stats_Mean_Integers", data: fractions("2", "4", "9"), want: "5"},
+		{name: "Stats_Mean_Fractions", data: fractions("1/2", "1/3", "1/6"), want: "1/3"},
+		{name: "Stats_Mean_NotWhole", data: fractions("1", "2"), want: "3/2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Mean(tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Mean() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Mean(nil); !errors.Is(err, ErrEmptyData) {
+		t.Errorf("Mean(nil) error = %v, want %v", err, ErrEmptyData)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name string
+		data []*basicmath.Fraction
+		want string
+	}{
+		{name: "Stats_Median_Odd", data: fractions("5", "1", "3"), want: "3"},
+		{name: "Stats_Median_Even", data: fractions("4", "1", "3", "2"), want: "5/2"},
+		{name: "Stats_Median_Fractions", data: fractions("3/4", "1/4"), want: "1/2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Median(tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Median() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMedian_KeepsOrder(t *testing.T) {
+	data := fractions("3", "1", "2")
+	if _, err := Median(data); err != nil {
+		t.Fatal(err)
+	}
+	if data[0].String() != "3" {
+		t.Error("Median() should not reorder its input")
+	}
+}
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		name string
+		data []*basicmath.Fraction
+		want []string
+	}{
+		{name: "Stats_Mode_Single", data: fractions("1", "2", "2", "3"), want: []string{"2"}},
+		{name: "Stats_Mode_Bimodal", data: fractions("3", "1", "1", "3", "2"), want: []string{"1", "3"}},
+		{name: "Stats_Mode_EquivalentFractions", data: fractions("1/2", "2/4", "1/3"), want: []string{"1/2"}},
+		{name: "Stats_Mode_None", data: fractions("1", "2", "3"), want: []string{}},
+		{name: "Stats_Mode_OneValue", data: fractions("7", "7"), want: []string{"7"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Mode(tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Mode() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("Mode() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
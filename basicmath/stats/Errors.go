@@ -0,0 +1,10 @@
+package stats
+
+import "errors"
+
+var (
+	// ErrEmptyData is returned when a statistic needs at least one value
+	ErrEmptyData = errors.New("stats: empty data set")
+	// ErrInsufficientData is returned when a sample statistic needs at least two values
+	ErrInsufficientData = errors.New("stats: need at least two values")
+)
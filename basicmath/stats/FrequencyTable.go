@@ -0,0 +1,90 @@
+package stats
+
+import (
+	"fmt"
+	"mymath/basicmath"
+	"mymath/latex"
+	"strconv"
+	"strings"
+)
+
+// FrequencyRow counts one distinct value of a data set
+type FrequencyRow struct {
+	Value               *basicmath.Fraction
+	Frequency           int
+	RelativeFrequency   *basicmath.Fraction
+	CumulativeFrequency int
+}
+
+// FrequencyTable lists every distinct value in ascending order with how often it occurs
+type FrequencyTable []FrequencyRow
+
+// #region Constructor
+
+// NewFrequencyTable counts the distinct values of data; equal values such as 1/2 and 2/4 are counted together
+func NewFrequencyTable(data []*basicmath.Fraction) FrequencyTable {
+	table := FrequencyTable{}
+
+	for _, value := range sorted(data) {
+		last := len(table) - 1
+		if last >= 0 && table[last].Value.Equals(value) {
+			table[last].Frequency++
+			continue
+		}
+
+		simplified := basicmath.NewFraction(value.Numerator(), value.Denominator())
+		simplified.Simplify()
+		table = append(table, FrequencyRow{Value: simplified, Frequency: 1})
+	}
+
+	cumulative := 0
+	for i := range table {
+		cumulative += table[i].Frequency
+		table[i].CumulativeFrequency = cumulative
+		table[i].RelativeFrequency = basicmath.NewFraction(table[i].Frequency, len(data))
+		table[i].RelativeFrequency.Simplify()
+	}
+
+	return table
+}
+
+// #endregion
+
+// #region Public Methods
+
+// Total returns the number of values counted
+func (t FrequencyTable) Total() int {
+	if len(t) == 0 {
+		return 0
+	}
+	return t[len(t)-1].CumulativeFrequency
+}
+
+// LaTeX renders the table with value, frequency, relative frequency and cumulative frequency columns
+func (t FrequencyTable) LaTeX() string {
+	rows := [][]string{
+		{latex.Text("Value"), latex.Text("Frequency"), latex.Text("Relative frequency"), latex.Text("Cumulative frequency")},
+		{`\hline`},
+	}
+
+	for _, row := range t {
+		rows = append(rows, []string{
+			latex.WriteMath(row.Value.LaTeX()),
+			strconv.Itoa(row.Frequency),
+			latex.WriteMath(row.RelativeFrequency.LaTeX()),
+			strconv.Itoa(row.CumulativeFrequency),
+		})
+	}
+
+	return latex.Tabular("c|ccc", rows...)
+}
+
+func (t FrequencyTable) String() string {
+	var sb strings.Builder
+	for _, row := range t {
+		sb.WriteString(fmt.Sprintf("%s: %d\n", row.Value, row.Frequency))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// #endregion
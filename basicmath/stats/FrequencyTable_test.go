@@ -0,0 +1,48 @@
+package stats
+
+import "testing"
+
+func TestNewFrequencyTable(t *testing.T) {
+	table := NewFrequencyTable(fractions("2", "1/2", "2", "2/4", "3"))
+
+	want := []struct {
+		value      string
+		frequency  int
+		relative   string
+		cumulative int
+	}{
+		{value: "1/2", frequency: 2, relative: "2/5", cumulative: 2},
+		{value: "2", frequency: 2, relative: "2/5", cumulative: 4},
+		{value: "3", frequency: 1, relative: "1/5", cumulative: 5},
+	}
+
+	if len(table) != len(want) {
+		t.Fatalf("NewFrequencyTable() has %d rows, want %d", len(table), len(want))
+	}
+	for i, row := range table {
+		if row.Value.String() != want[i].value || row.Frequency != want[i].frequency ||
+			row.RelativeFrequency.String() != want[i].relative || row.CumulativeFrequency != want[i].cumulative {
+			t.Errorf("row %d = %s %d %s %d, want %+v", i, row.Value, row.Frequency, row.RelativeFrequency, row.CumulativeFrequency, want[i])
+		}
+	}
+
+	if table.Total() != 5 {
+		t.Errorf("Total() = %d, want 5", table.Total())
+	}
+	if table.String() != "1/2: 2\n2: 2\n3: 1" {
+		t.Errorf("String() = %q", table.String())
+	}
+}
+
+func TestFrequencyTable_LaTeX(t *testing.T) {
+	want := "\\begin{tabular}{c|ccc}\n" +
+		"\\text{Value} & \\text{Frequency} & \\text{Relative frequency} & \\text{Cumulative frequency} \\\\\n" +
+		"\\hline\n" +
+		"$1$ & 1 & $\\dfrac{1}{3}$ & 1 \\\\\n" +
+		"$4$ & 2 & $\\dfrac{2}{3}$ & 3 \\\\\n" +
+		"\\end{tabular}"
+
+	if got := NewFrequencyTable(fractions("4", "1", "4")).LaTeX(); got != want {
+		t.Errorf("FrequencyTable.LaTeX() = %q, want %q", got, want)
+	}
+}
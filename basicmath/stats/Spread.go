@@ -0,0 +1,185 @@
+package stats
+
+import (
+	"fmt"
+	"mymath/basicmath"
+	"mymath/latex"
+)
+
+// FiveNumberSummary is the minimum, quartiles and maximum of a data set
+type FiveNumberSummary struct {
+	Minimum *basicmath.Fraction
+	Q1      *basicmath.Fraction
+	Median  *basicmath.Fraction
+	Q3      *basicmath.Fraction
+	Maximum *basicmath.Fraction
+}
+
+// #region Public Methods
+
+// Range returns the maximum minus the minimum
+func Range(data []*basicmath.Fraction) (*basicmath.Fraction, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyData
+	}
+
+	values := sorted(data)
+
+	return values[len(values)-1].TrySubtract(values[0])
+}
+
+// PopulationVariance returns the exact mean squared deviation from the mean, dividing by n
+func PopulationVariance(data []*basicmath.Fraction) (*basicmath.Fraction, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyData
+	}
+
+	squares, err := sumOfSquaredDeviations(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return squares.TryDivide(basicmath.NewInteger(len(data)))
+}
+
+// SampleVariance returns the exact sample variance, dividing by n - 1
+func SampleVariance(data []*basicmath.Fraction) (*basicmath.Fraction, error) {
+	if len(data) < 2 {
+		return nil, ErrInsufficientData
+	}
+
+	squares, err := sumOfSquaredDeviations(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return squares.TryDivide(basicmath.NewInteger(len(data) - 1))
+}
+
+// PopulationStandardDeviation returns the square root of the population variance as an exact
+// simplified radical; use ToFloat64 on the result for a decimal value
+func PopulationStandardDeviation(data []*basicmath.Fraction) (*basicmath.Radical, error) {
+	variance, err := PopulationVariance(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return variance.Root(2)
+}
+
+// SampleStandardDeviation returns the square root of the sample variance as an exact
+// simplified radical; use ToFloat64 on the result for a decimal value
+func SampleStandardDeviation(data []*basicmath.Fraction) (*basicmath.Radical, error) {
+	variance, err := SampleVariance(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return variance.Root(2)
+}
+
+// Quartiles returns Q1, the median and Q3. Q1 and Q3 are the medians of the lower and upper
+// halves of the sorted data, leaving out the median itself when the count is odd.
+func Quartiles(data []*basicmath.Fraction) (q1, q2, q3 *basicmath.Fraction, err error) {
+	if len(data) == 0 {
+		return nil, nil, nil, ErrEmptyData
+	}
+
+	values := sorted(data)
+	if len(values) == 1 {
+		return copyOf(values[0]), copyOf(values[0]), copyOf(values[0]), nil
+	}
+
+	half := len(values) / 2
+	lower, upper := values[:half], values[len(values)-half:]
+
+	if q1, err = median(lower); err != nil {
+		return nil, nil, nil, err
+	}
+	if q2, err = median(values); err != nil {
+		return nil, nil, nil, err
+	}
+	if q3, err = median(upper); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return q1, q2, q3, nil
+}
+
+// InterquartileRange returns Q3 - Q1
+func InterquartileRange(data []*basicmath.Fraction) (*basicmath.Fraction, error) {
+	q1, _, q3, err := Quartiles(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return q3.TrySubtract(q1)
+}
+
+// Summarize returns the five-number summary used to draw a box plot
+func Summarize(data []*basicmath.Fraction) (*FiveNumberSummary, error) {
+	q1, q2, q3, err := Quartiles(data)
+	if err != nil {
+		return nil, err
+	}
+
+	values := sorted(data)
+
+	return &FiveNumberSummary{
+		Minimum: copyOf(values[0]),
+		Q1:      q1,
+		Median:  q2,
+		Q3:      q3,
+		Maximum: copyOf(values[len(values)-1]),
+	}, nil
+}
+
+// LaTeX renders the summary as a two-row tabular
+func (s *FiveNumberSummary) LaTeX() string {
+	return latex.Tabular("ccccc",
+		[]string{latex.Text("Min"), "$Q_1$", latex.Text("Median"), "$Q_3$", latex.Text("Max")},
+		[]string{`\hline`},
+		[]string{
+			latex.WriteMath(s.Minimum.LaTeX()),
+			latex.WriteMath(s.Q1.LaTeX()),
+			latex.WriteMath(s.Median.LaTeX()),
+			latex.WriteMath(s.Q3.LaTeX()),
+			latex.WriteMath(s.Maximum.LaTeX()),
+		})
+}
+
+func (s *FiveNumberSummary) String() string {
+	return fmt.Sprintf("min %s, Q1 %s, median %s, Q3 %s, max %s", s.Minimum, s.Q1, s.Median, s.Q3, s.Maximum)
+}
+
+// #endregion
+
+// #region Private Methods
+
+func sumOfSquaredDeviations(data []*basicmath.Fraction) (*basicmath.Fraction, error) {
+	mean, err := Mean(data)
+	if err != nil {
+		return nil, err
+	}
+
+	total := basicmath.NewInteger(0)
+	for _, value := range data {
+		deviation, err := value.TrySubtract(mean)
+		if err != nil {
+			return nil, err
+		}
+
+		square, err := deviation.TryMultiply(deviation)
+		if err != nil {
+			return nil, err
+		}
+
+		if total, err = total.TryAdd(square); err != nil {
+			return nil, err
+		}
+	}
+
+	return total, nil
+}
+
+// #endregion
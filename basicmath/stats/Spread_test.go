@@ -0,0 +1,140 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"mymath/basicmath"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	got, err := Range(fractions("1/2", "-3", "7/4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "19/4" {
+		t.Errorf("Range() = %s, want 19/4", got)
+	}
+}
+
+func TestVariance(t *testing.T) {
+	data := fractions("2", "4", "4", "4", "5", "5", "7", "9")
+
+	population, err := PopulationVariance(data)
+	if err != nil || population.String() != "4" {
+		t.Errorf("PopulationVariance() = %v, %v, want 4", population, err)
+	}
+
+	sample, err := SampleVariance(data)
+	if err != nil || sample.String() != "32/7" {
+		t.Errorf("SampleVariance() = %v, %v, want 32/7", sample, err)
+	}
+
+	if _, err := SampleVariance(fractions("1")); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("SampleVariance() error = %v, want %v", err, ErrInsufficientData)
+	}
+}
+
+func TestStandardDeviation(t *testing.T) {
+	data := fractions("2", "4", "4", "4", "5", "5", "7", "9")
+
+	population, err := PopulationStandardDeviation(data)
+	if err != nil || population.String() != "2" {
+		t.Errorf("PopulationStandardDeviation() = %v, %v, want 2", population, err)
+	}
+
+	sample, err := SampleStandardDeviation(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sample.String() != "(4/7)√14" {
+		t.Errorf("SampleStandardDeviation() = %s, want (4/7)√14", sample)
+	}
+	if math.Abs(sample.ToFloat64()-math.Sqrt(32.0/7)) > 1e-12 {
+		t.Errorf("SampleStandardDeviation().ToFloat64() = %v", sample.ToFloat64())
+	}
+
+	fractional, err := PopulationStandardDeviation(fractions("0", "1/2"))
+	if err != nil || fractional.String() != "1/4" {
+		t.Errorf("PopulationStandardDeviation() = %v, %v, want 1/4", fractional, err)
+	}
+}
+
+func TestQuartiles(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           []string
+		q1, median, q3 string
+		iqr            string
+	}{
+		{name: "Stats_Quartiles_Odd", data: []string{"1", "2", "3", "4", "5", "6", "7"}, q1: "2", median: "4", q3: "6", iqr: "4"},
+		{name: "Stats_Quartiles_Even", data: []string{"8", "1", "2", "3", "4", "5", "6", "7"}, q1: "5/2", median: "9/2", q3: "13/2", iqr: "4"},
+		{name: "Stats_Quartiles_Single", data: []string{"3/2"}, q1: "3/2", median: "3/2", q3: "3/2", iqr: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q1, median, q3, err := Quartiles(fractions(tt.data...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if q1.String() != tt.q1 || median.String() != tt.median || q3.String() != tt.q3 {
+				t.Errorf("Quartiles() = %s, %s, %s, want %s, %s, %s", q1, median, q3, tt.q1, tt.median, tt.q3)
+			}
+
+			iqr, err := InterquartileRange(fractions(tt.data...))
+			if err != nil || iqr.String() != tt.iqr {
+				t.Errorf("InterquartileRange() = %v, %v, want %s", iqr, err, tt.iqr)
+			}
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	summary, err := Summarize(fractions("6", "1/2", "3", "2", "10"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := summary.String(); got != "min 1/2, Q1 5/4, median 3, Q3 8, max 10" {
+		t.Errorf("FiveNumberSummary.String() = %q", got)
+	}
+
+	want := "\\begin{tabular}{ccccc}\n" +
+		"\\text{Min} & $Q_1$ & \\text{Median} & $Q_3$ & \\text{Max} \\\\\n" +
+		"\\hline\n" +
+		"$\\dfrac{1}{2}$ & $\\dfrac{5}{4}$ & $3$ & $8$ & $10$ \\\\\n" +
+		"\\end{tabular}"
+	if got := summary.LaTeX(); got != want {
+		t.Errorf("FiveNumberSummary.LaTeX() = %q, want %q", got, want)
+	}
+}
+
+func TestSummarize_CopiesData(t *testing.T) {
+	data := fractions("6", "1/2", "3", "2", "10")
+	summary, err := Summarize(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, value := range []*basicmath.Fraction{summary.Minimum, summary.Q1, summary.Median, summary.Q3, summary.Maximum} {
+		if err := value.UnmarshalJSON([]byte(`"99"`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := fmt.Sprint(data); got != "[6 1/2 3 2 10]" {
+		t.Errorf("changing the summary changed the data to %s", got)
+	}
+
+	single := fractions("3/2")
+	q1, _, _, err := Quartiles(single)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q1.UnmarshalJSON([]byte(`"99"`)); err != nil {
+		t.Fatal(err)
+	}
+	if got := single[0].String(); got != "3/2" {
+		t.Errorf("changing Q1 changed the data to %s", got)
+	}
+}
@@ -0,0 +1,76 @@
+package combinatorics
+
+import (
+	"math/big"
+	"mymath/datastructures"
+)
+
+// #region Public Methods
+
+// Factorial returns n!
+func Factorial(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, ErrNegative
+	}
+
+	return new(big.Int).MulRange(1, int64(n)), nil
+}
+
+// Permutations returns nPr = n! / (n - r)!, the number of ordered arrangements of r items chosen from n.
+// It is 0 when r > n.
+func Permutations(n, r int) (*big.Int, error) {
+	if n < 0 || r < 0 {
+		return nil, ErrNegative
+	}
+	if r > n {
+		return big.NewInt(0), nil
+	}
+
+	return new(big.Int).MulRange(int64(n-r+1), int64(n)), nil
+}
+
+// Combinations returns nCr = n! / (r! (n - r)!), the number of ways to choose r items from n.
+// It is 0 when r > n.
+func Combinations(n, r int) (*big.Int, error) {
+	if n < 0 || r < 0 {
+		return nil, ErrNegative
+	}
+	if r > n {
+		return big.NewInt(0), nil
+	}
+
+	return new(big.Int).Binomial(int64(n), int64(r)), nil
+}
+
+// Multinomial returns (k1 + k2 + ...)! / (k1! k2! ...), the number of ways to split a set into groups of the given sizes
+func Multinomial(counts ...int) (*big.Int, error) {
+	result := big.NewInt(1)
+	total := 0
+
+	// build the product one binomial at a time: C(k1, k1) · C(k1 + k2, k2) · ...
+	for _, count := range counts {
+		if count < 0 {
+			return nil, ErrNegative
+		}
+
+		total += count
+		result.Mul(result, new(big.Int).Binomial(int64(total), int64(count)))
+	}
+
+	return result, nil
+}
+
+// DistinctPermutationCount returns how many different orderings items has when equal items are
+// indistinguishable, e.g. 3 for [a a b]
+func DistinctPermutationCount[T comparable](items []T) *big.Int {
+	counts := make(map[T]int)
+	for _, item := range items {
+		counts[item]++
+	}
+
+	result, _ := Multinomial(datastructures.Values(counts)...)
+
+	return result
+}
+
+// #endregion
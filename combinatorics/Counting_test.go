@@ -0,0 +1,87 @@
+package combinatorics
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFactorial(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want string
+	}{
+		{name: "Combinatorics_Factorial_Zero", n: 0, want: "1"},
+		{name: "Combinatorics_Factorial_Five", n: 5, want: "120"},
+		{name: "Combinatorics_Factorial_Large", n: 25, want: "15511210043330985984000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Factorial(tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Factorial(%d) = %s, want %s", tt.n, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Factorial(-1); !errors.Is(err, ErrNegative) {
+		t.Errorf("Factorial(-1) error = %v, want %v", err, ErrNegative)
+	}
+}
+
+func TestPermutationsAndCombinations(t *testing.T) {
+	tests := []struct {
+		name             string
+		n, r             int
+		wantPermutations string
+		wantCombinations string
+	}{
+		{name: "Combinatorics_Counts_Basic", n: 5, r: 2, wantPermutations: "20", wantCombinations: "10"},
+		{name: "Combinatorics_Counts_ChooseNone", n: 7, r: 0, wantPermutations: "1", wantCombinations: "1"},
+		{name: "Combinatorics_Counts_ChooseAll", n: 4, r: 4, wantPermutations: "24", wantCombinations: "1"},
+		{name: "Combinatorics_Counts_TooMany", n: 3, r: 5, wantPermutations: "0", wantCombinations: "0"},
+		{name: "Combinatorics_Counts_Large", n: 100, r: 50, wantPermutations: "3068518756254966037202730459529469739228459721684688959447786986982158958772355072000000000000", wantCombinations: "100891344545564193334812497256"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permutations, err := Permutations(tt.n, tt.r)
+			if err != nil || permutations.String() != tt.wantPermutations {
+				t.Errorf("Permutations(%d, %d) = %v, %v, want %s", tt.n, tt.r, permutations, err, tt.wantPermutations)
+			}
+
+			combinations, err := Combinations(tt.n, tt.r)
+			if err != nil || combinations.String() != tt.wantCombinations {
+				t.Errorf("Combinations(%d, %d) = %v, %v, want %s", tt.n, tt.r, combinations, err, tt.wantCombinations)
+			}
+		})
+	}
+
+	if _, err := Combinations(-2, 1); !errors.Is(err, ErrNegative) {
+		t.Errorf("Combinations(-2, 1) error = %v, want %v", err, ErrNegative)
+	}
+}
+
+func TestMultinomial(t *testing.T) {
+	got, err := Multinomial(2, 3, 4)
+	if err != nil || got.String() != "1260" {
+		t.Errorf("Multinomial(2, 3, 4) = %v, %v, want 1260", got, err)
+	}
+
+	got, err = Multinomial()
+	if err != nil || got.String() != "1" {
+		t.Errorf("Multinomial() = %v, %v, want 1", got, err)
+	}
+
+	if _, err := Multinomial(1, -1); !errors.Is(err, ErrNegative) {
+		t.Errorf("Multinomial(1, -1) error = %v, want %v", err, ErrNegative)
+	}
+}
+
+func TestDistinctPermutationCount(t *testing.T) {
+	if got := DistinctPermutationCount([]rune("MISSISSIPPI")); got.String() != "34650" {
+		t.Errorf("DistinctPermutationCount(MISSISSIPPI) = %s, want 34650", got)
+	}
+}
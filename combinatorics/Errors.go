@@ -0,0 +1,8 @@
+package combinatorics
+
+import "errors"
+
+var (
+	// ErrNegative is returned when a count or size is negative
+	ErrNegative = errors.New("combinatorics: negative argument")
+)
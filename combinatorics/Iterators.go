@@ -0,0 +1,214 @@
+package combinatorics
+
+// CombinationIterator steps through every k-item combination of a slice in lexicographic order of position:
+//
+//	it := NewCombinationIterator([]string{"a", "b", "c"}, 2)
+//	for it.Next() {
+//		fmt.Println(it.Value()) // [a b], [a c], [b c]
+//	}
+type CombinationIterator[T any] struct {
+	items   []T
+	indices []int
+	started bool
+	done    bool
+}
+
+// PermutationIterator steps through every ordered arrangement of k items of a slice in lexicographic order of position
+type PermutationIterator[T any] struct {
+	items   []T
+	k       int
+	indices []int
+	cycles  []int
+	started bool
+	done    bool
+}
+
+// DistinctPermutationIterator steps through every distinct ordering of a slice, treating equal items as
+// indistinguishable, so [a a b] gives [a a b], [a b a] and [b a a]
+type DistinctPermutationIterator[T comparable] struct {
+	representatives []T
+	classes         []int
+	started         bool
+	done            bool
+}
+
+// #region Constructor
+
+func NewCombinationIterator[T any](items []T, k int) *CombinationIterator[T] {
+	it := &CombinationIterator[T]{items: items, done: k < 0 || k > len(items)}
+	if !it.done {
+		it.indices = make([]int, k)
+		for i := range it.indices {
+			it.indices[i] = i
+		}
+	}
+	return it
+}
+
+func NewPermutationIterator[T any](items []T, k int) *PermutationIterator[T] {
+	n := len(items)
+	it := &PermutationIterator[T]{items: items, k: k, done: k < 0 || k > n}
+	if !it.done {
+		it.indices = make([]int, n)
+		for i := range it.indices {
+			it.indices[i] = i
+		}
+		it.cycles = make([]int, k)
+		for i := range it.cycles {
+			it.cycles[i] = n - i
+		}
+	}
+	return it
+}
+
+func NewDistinctPermutationIterator[T comparable](items []T) *DistinctPermutationIterator[T] {
+	it := &DistinctPermutationIterator[T]{}
+
+	// number each distinct value in order of first appearance
+	class := make(map[T]int)
+	for _, item := range items {
+		if _, ok := class[item]; !ok {
+			class[item] = len(it.representatives)
+			it.representatives = append(it.representatives, item)
+		}
+	}
+
+	counts := make([]int, len(it.representatives))
+	for _, item := range items {
+		counts[class[item]]++
+	}
+	for c, count := range counts {
+		for i := 0; i < count; i++ {
+			it.classes = append(it.classes, c)
+		}
+	}
+
+	return it
+}
+
+// #endregion
+
+// #region Public Methods
+
+// Next advances to the next combination, returning false once they are exhausted
+func (it *CombinationIterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	if !it.started {
+		it.started = true
+		return true
+	}
+
+	n, k := len(it.items), len(it.indices)
+
+	i := k - 1
+	for i >= 0 && it.indices[i] == i+n-k {
+		i--
+	}
+	if i < 0 {
+		it.done = true
+		return false
+	}
+
+	it.indices[i]++
+	for j := i + 1; j < k; j++ {
+		it.indices[j] = it.indices[j-1] + 1
+	}
+
+	return true
+}
+
+// Value returns a new slice holding the current combination
+func (it *CombinationIterator[T]) Value() []T {
+	return pick(it.items, it.indices)
+}
+
+// Next advances to the next permutation, returning false once they are exhausted
+func (it *PermutationIterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	if !it.started {
+		it.started = true
+		return true
+	}
+
+	n := len(it.items)
+	for i := it.k - 1; i >= 0; i-- {
+		it.cycles[i]--
+		if it.cycles[i] == 0 {
+			// move indices[i] to the end and reset its counter
+			moved := it.indices[i]
+			copy(it.indices[i:], it.indices[i+1:])
+			it.indices[n-1] = moved
+			it.cycles[i] = n - i
+			continue
+		}
+
+		j := n - it.cycles[i]
+		it.indices[i], it.indices[j] = it.indices[j], it.indices[i]
+		return true
+	}
+
+	it.done = true
+	return false
+}
+
+// Value returns a new slice holding the current permutation
+func (it *PermutationIterator[T]) Value() []T {
+	return pick(it.items, it.indices[:it.k])
+}
+
+// Next advances to the next distinct permutation, returning false once they are exhausted
+func (it *DistinctPermutationIterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	if !it.started {
+		it.started = true
+		return true
+	}
+
+	// standard next lexicographic permutation of the class numbers
+	a := it.classes
+	i := len(a) - 2
+	for i >= 0 && a[i] >= a[i+1] {
+		i--
+	}
+	if i < 0 {
+		it.done = true
+		return false
+	}
+
+	j := len(a) - 1
+	for a[j] <= a[i] {
+		j--
+	}
+	a[i], a[j] = a[j], a[i]
+
+	for l, r := i+1, len(a)-1; l < r; l, r = l+1, r-1 {
+		a[l], a[r] = a[r], a[l]
+	}
+
+	return true
+}
+
+// Value returns a new slice holding the current permutation
+func (it *DistinctPermutationIterator[T]) Value() []T {
+	return pick(it.representatives, it.classes)
+}
+
+// #endregion
+
+// #region Private Methods
+
+func pick[T any](items []T, indices []int) []T {
+	values := make([]T, len(indices))
+	for i, index := range indices {
+		values[i] = items[index]
+	}
+	return values
+}
+
+// #endregion
@@ -0,0 +1,79 @@
+package combinatorics
+
+import (
+	"fmt"
+	"testing"
+)
+
+func collect[T any](next func() bool, value func() []T) []string {
+	values := []string{}
+	for next() {
+		values = append(values, fmt.Sprint(value()))
+	}
+	return values
+}
+
+func TestCombinationIterator(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []string
+		k     int
+		want  string
+	}{
+		{name: "Combinatorics_CombinationIterator_Pairs", items: []string{"a", "b", "c", "d"}, k: 2, want: "[[a b] [a c] [a d] [b c] [b d] [c d]]"},
+		{name: "Combinatorics_CombinationIterator_All", items: []string{"a", "b"}, k: 2, want: "[[a b]]"},
+		{name: "Combinatorics_CombinationIterator_Empty", items: []string{"a", "b"}, k: 0, want: "[[]]"},
+		{name: "Combinatorics_CombinationIterator_TooMany", items: []string{"a"}, k: 2, want: "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := NewCombinationIterator(tt.items, tt.k)
+			if got := fmt.Sprint(collect(it.Next, it.Value)); got != tt.want {
+				t.Errorf("combinations = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermutationIterator(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []int
+		k     int
+		want  string
+	}{
+		{name: "Combinatorics_PermutationIterator_Full", items: []int{1, 2, 3}, k: 3, want: "[[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]]"},
+		{name: "Combinatorics_PermutationIterator_Partial", items: []int{1, 2, 3}, k: 2, want: "[[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]"},
+		{name: "Combinatorics_PermutationIterator_Empty", items: []int{1, 2}, k: 0, want: "[[]]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := NewPermutationIterator(tt.items, tt.k)
+			if got := fmt.Sprint(collect(it.Next, it.Value)); got != tt.want {
+				t.Errorf("permutations = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermutationIterator_MatchesCount(t *testing.T) {
+	it := NewPermutationIterator([]int{1, 2, 3, 4, 5, 6}, 4)
+	count := 0
+	for it.Next() {
+		count++
+	}
+
+	want, _ := Permutations(6, 4)
+	if int64(count) != want.Int64() {
+		t.Errorf("iterated %d permutations, want %s", count, want)
+	}
+}
+
+func TestDistinctPermutationIterator(t *testing.T) {
+	it := NewDistinctPermutationIterator([]string{"b", "a", "b"})
+	got := fmt.Sprint(collect(it.Next, it.Value))
+	want := "[[b b a] [b a b] [a b b]]"
+	if got != want {
+		t.Errorf("distinct permutations = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,48 @@
+package combinatorics
+
+import (
+	"math/big"
+	"mymath/latex"
+	"strings"
+)
+
+// #region Public Methods
+
+// PascalsTriangle returns the first rows rows of Pascal's triangle; row n holds C(n, 0) through C(n, n)
+func PascalsTriangle(rows int) [][]*big.Int {
+	triangle := [][]*big.Int{}
+
+	for n := 0; n < rows; n++ {
+		row := make([]*big.Int, n+1)
+		row[0], row[n] = big.NewInt(1), big.NewInt(1)
+		for k := 1; k < n; k++ {
+			row[k] = new(big.Int).Add(triangle[n-1][k-1], triangle[n-1][k])
+		}
+		triangle = append(triangle, row)
+	}
+
+	return triangle
+}
+
+// PascalsTriangleLaTeX renders the first rows rows as a centered tabular, offsetting each row by
+// half an entry so every number sits between the two above it
+func PascalsTriangleLaTeX(rows int) string {
+	if rows <= 0 {
+		return ""
+	}
+
+	width := 2*rows - 1
+	lines := [][]string{}
+
+	for n, row := range PascalsTriangle(rows) {
+		cells := make([]string, width)
+		for k, value := range row {
+			cells[rows-1-n+2*k] = value.String()
+		}
+		lines = append(lines, cells)
+	}
+
+	return latex.Tabular(strings.Repeat("c", width), lines...)
+}
+
+// #endregion
@@ -0,0 +1,30 @@
+package combinatorics
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPascalsTriangle(t *testing.T) {
+	got := fmt.Sprint(PascalsTriangle(5))
+	want := "[[1] [1 1] [1 2 1] [1 3 3 1] [1 4 6 4 1]]"
+	if got != want {
+		t.Errorf("PascalsTriangle(5) = %s, want %s", got, want)
+	}
+
+	if len(PascalsTriangle(0)) != 0 {
+		t.Error("PascalsTriangle(0) should be empty")
+	}
+}
+
+func TestPascalsTriangleLaTeX(t *testing.T) {
+	want := "\\begin{tabular}{ccccc}\n" +
+		" &  & 1 &  &  \\\\\n" +
+		" & 1 &  & 1 &  \\\\\n" +
+		"1 &  & 2 &  & 1 \\\\\n" +
+		"\\end{tabular}"
+
+	if got := PascalsTriangleLaTeX(3); got != want {
+		t.Errorf("PascalsTriangleLaTeX(3) = %q, want %q", got, want)
+	}
+}
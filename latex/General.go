@@ -5,6 +5,11 @@ import (
 	"strings"
 )
 
+// Align wraps lines in an align* environment, one line per row
+func Align(lines ...string) string {
+	return fmt.Sprintf("\\begin{align*}\n%s\n\\end{align*}", strings.Join(lines, " \\\\\n"))
+}
+
 func ConnectWithMinusSign(a, b string) string {
 	return connectWithSign("-", a, b)
 }
@@ -13,6 +18,33 @@ func ConnectWithPlusSign(a, b string) string {
 	return connectWithSign("+", a, b)
 }
 
+// Cline draws a rule under columns first through last (1-based) of a tabular
+func Cline(first, last int) string {
+	return fmt.Sprintf(`\cline{%d-%d}`, first, last)
+}
+
+// Tabular builds a tabular environment with the given column spec; a row holding only \hline or a Cline draws a rule
+func Tabular(columns string, rows ...[]string) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("\\begin{tabular}{%s}\n", columns))
+	for _, row := range rows {
+		if len(row) == 1 && (row[0] == `\hline` || strings.HasPrefix(row[0], `\cline{`)) {
+			sb.WriteString(row[0] + "\n")
+			continue
+		}
+		sb.WriteString(strings.Join(row, " & "))
+		sb.WriteString(" \\\\\n")
+	}
+	sb.WriteString(`\end{tabular}`)
+
+	return sb.String()
+}
+
+func Text(text string) string {
+	return fmt.Sprintf(`\text{%s}`, text)
+}
+
 func WrapInBrackets(text string) string {
 	return fmt.Sprintf(`\left[%s\right]`, text)
 }
@@ -63,3 +63,51 @@ func TestConnectWithPlusSign(t *testing.T) {
 		})
 	}
 }
+
+func TestAlign(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  string
+	}{
+		{
+			name:  "LaTeX_Align_Test01",
+			lines: []string{"x &= 1", "y &= 2"},
+			want:  "\\begin{align*}\nx &= 1 \\\\\ny &= 2\n\\end{align*}",
+		},
+		{
+			name:  "LaTeX_Align_Test02",
+			lines: []string{"x &= 1"},
+			want:  "\\begin{align*}\nx &= 1\n\\end{align*}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Align(tt.lines...); got != tt.want {
+				t.Errorf("Align() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTabular(t *testing.T) {
+	got := Tabular("cc", []string{"a", "b"}, []string{`\hline`}, []string{"1", "2"})
+	want := "\\begin{tabular}{cc}\na & b \\\\\n\\hline\n1 & 2 \\\\\n\\end{tabular}"
+	if got != want {
+		t.Errorf("Tabular() = %v, want %v", got, want)
+	}
+}
+
+func TestTabular_Cline(t *testing.T) {
+	got := Tabular("rr", []string{"1", "2"}, []string{Cline(2, 2)}, []string{"", "3"})
+	want := "\\begin{tabular}{rr}\n1 & 2 \\\\\n\\cline{2-2}\n & 3 \\\\\n\\end{tabular}"
+	if got != want {
+		t.Errorf("Tabular() = %v, want %v", got, want)
+	}
+}
+
+func TestText(t *testing.T) {
+	if got, want := Text("find the LCD"), `\text{find the LCD}`; got != want {
+		t.Errorf("Text() = %v, want %v", got, want)
+	}
+}
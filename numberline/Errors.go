@@ -0,0 +1,14 @@
+package numberline
+
+import "errors"
+
+var (
+	// ErrInvalidPoint is returned for a nil fraction or the zero value Fraction{}, which has a zero denominator
+	ErrInvalidPoint = errors.New("numberline: point must be a fraction with a nonzero denominator")
+	// ErrInvalidRange is returned when the start of a range is not before its end
+	ErrInvalidRange = errors.New("numberline: range start must be less than range end")
+	// ErrOutOfRange is returned when a point lies outside the range of the number line
+	ErrOutOfRange = errors.New("numberline: point outside the range")
+	// ErrTooManyTicks is returned when the tick spacing would draw more than MaxTicks ticks
+	ErrTooManyTicks = errors.New("numberline: too many ticks to draw")
+)
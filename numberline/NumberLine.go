@@ -0,0 +1,201 @@
+package numberline
+
+import "mymath/basicmath"
+
+// MaxTicks bounds how many ticks a number line will draw
+const MaxTicks = 200
+
+// Marker is how a point is drawn on the line
+type Marker int
+
+const (
+	// Closed draws a filled dot, for a value that is included
+	Closed Marker = iota
+	// Open draws a hollow dot, for a value that is excluded
+	Open
+)
+
+// Point is a value plotted on a number line
+type Point struct {
+	Value  *basicmath.Fraction
+	Marker Marker
+}
+
+// NumberLine plots fractions on a line with ticks spaced by one over the least common multiple of
+// the denominators, so every plotted value falls on a tick
+type NumberLine struct {
+	points     []Point
+	start      *basicmath.Fraction
+	end        *basicmath.Fraction
+	labelTicks bool
+}
+
+// #region Constructor
+
+// NewNumberLine plots each value with a closed marker; it panics with ErrInvalidPoint, see NewNumberLineE
+func NewNumberLine(values ...*basicmath.Fraction) *NumberLine {
+	l, err := NewNumberLineE(values...)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// NewNumberLineE plots each value with a closed marker, returning ErrInvalidPoint for a nil or zero value fraction
+func NewNumberLineE(values ...*basicmath.Fraction) (*NumberLine, error) {
+	l := &NumberLine{}
+	for _, value := range values {
+		if err := l.Add(value, Closed); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// #endregion
+
+// #region Properties
+
+func (l *NumberLine) Points() []Point {
+	points := make([]Point, len(l.points))
+	copy(points, l.points)
+	return points
+}
+
+// Range returns the ends of the line. Unless SetRange was called the line runs from the whole number
+// at or below the smallest point to the whole number at or above the largest, and always includes 0 to 1
+// when there are no points.
+func (l *NumberLine) Range() (start *basicmath.Fraction, end *basicmath.Fraction) {
+	if l.start != nil {
+		return l.start, l.end
+	}
+	if len(l.points) == 0 {
+		return basicmath.NewInteger(0), basicmath.NewInteger(1)
+	}
+
+	smallest, largest := l.points[0].Value, l.points[0].Value
+	for _, point := range l.points[1:] {
+		if point.Value.LessThan(smallest) {
+			smallest = point.Value
+		}
+		if point.Value.GreaterThan(largest) {
+			largest = point.Value
+		}
+	}
+
+	low, high := floor(smallest), ceiling(largest)
+	if low == high {
+		high++
+	}
+	return basicmath.NewInteger(low), basicmath.NewInteger(high)
+}
+
+// #endregion
+
+// #region Public Methods
+
+// Add plots a value with the given marker; a nil or zero value fraction is ErrInvalidPoint
+func (l *NumberLine) Add(value *basicmath.Fraction, marker Marker) error {
+	if !isValid(value) {
+		return ErrInvalidPoint
+	}
+	l.points = append(l.points, Point{Value: value, Marker: marker})
+	return nil
+}
+
+// SetRange fixes the ends of the line instead of fitting them to the points; a nil or zero value end
+// is ErrInvalidPoint
+func (l *NumberLine) SetRange(start *basicmath.Fraction, end *basicmath.Fraction) error {
+	if !isValid(start) || !isValid(end) {
+		return ErrInvalidPoint
+	}
+	if !start.LessThan(end) {
+		return ErrInvalidRange
+	}
+	l.start, l.end = start, end
+	return nil
+}
+
+// SetLabelTicks chooses whether every tick is labeled, e.g. 0, \dfrac{1}{4}, \dfrac{2}{4}, \dfrac{3}{4}, 1.
+// By default only the whole numbers are labeled.
+func (l *NumberLine) SetLabelTicks(labelTicks bool) {
+	l.labelTicks = labelTicks
+}
+
+// TickSpacing is one over the least common multiple of the denominators of the points and the range ends,
+// e.g. 1/12 for points at 1/4 and 2/3
+func (l *NumberLine) TickSpacing() *basicmath.Fraction {
+	return basicmath.NewFraction(1, l.denominator())
+}
+
+// Ticks lists every tick from the start of the line to the end, unsimplified so each has the common
+// denominator, e.g. 0/4, 1/4, 2/4, 3/4, 4/4
+func (l *NumberLine) Ticks() ([]*basicmath.Fraction, error) {
+	start, end := l.Range()
+	for _, point := range l.points {
+		if point.Value.LessThan(start) || point.Value.GreaterThan(end) {
+			return nil, ErrOutOfRange
+		}
+	}
+
+	denominator := l.denominator()
+	first := ceiling(start.Multiply(basicmath.NewInteger(denominator)))
+	last := floor(end.Multiply(basicmath.NewInteger(denominator)))
+	if last-first+1 > MaxTicks {
+		return nil, ErrTooManyTicks
+	}
+
+	ticks := make([]*basicmath.Fraction, 0, last-first+1)
+	for k := first; k <= last; k++ {
+		ticks = append(ticks, basicmath.NewFraction(k, denominator))
+	}
+	return ticks, nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+func (l *NumberLine) denominator() int {
+	start, end := l.Range()
+	denominators := []int{denominatorOf(start), denominatorOf(end)}
+	for _, point := range l.points {
+		denominators = append(denominators, denominatorOf(point.Value))
+	}
+	return basicmath.LCM(denominators...)
+}
+
+// reports whether f can be plotted; Fraction{} has a zero denominator
+func isValid(f *basicmath.Fraction) bool {
+	return f != nil && f.Denominator() != 0
+}
+
+// the denominator in lowest terms
+func denominatorOf(f *basicmath.Fraction) int {
+	n, d := f.Numerator(), f.Denominator()
+	return basicmath.Abs(d / basicmath.GCF(n, d))
+}
+
+func floor(f *basicmath.Fraction) int {
+	n, d := f.Numerator(), f.Denominator()
+	if d < 0 {
+		n, d = -n, -d
+	}
+	q := n / d
+	if n%d != 0 && n < 0 {
+		q--
+	}
+	return q
+}
+
+func ceiling(f *basicmath.Fraction) int {
+	return -floor(basicmath.NewFraction(-f.Numerator(), f.Denominator()))
+}
+
+// how far along the line value lies, from 0 at the start to length at the end
+func (l *NumberLine) position(value *basicmath.Fraction, length float64) float64 {
+	start, end := l.Range()
+	return value.Subtract(start).Divide(end.Subtract(start)).ToFloat64() * length
+}
+
+// #endregion
@@ -0,0 +1,113 @@
+package numberline
+
+import (
+	"mymath/basicmath"
+	"testing"
+)
+
+func TestNumberLine_TickSpacing(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []*basicmath.Fraction
+		want   *basicmath.Fraction
+	}{
+		{"NumberLine_TickSpacing_Empty", nil, basicmath.NewInteger(1)},
+		{"NumberLine_TickSpacing_Quarters", []*basicmath.Fraction{basicmath.NewFraction(1, 4), basicmath.NewFraction(3, 4)}, basicmath.NewFraction(1, 4)},
+		{"NumberLine_TickSpacing_Lcm", []*basicmath.Fraction{basicmath.NewFraction(1, 4), basicmath.NewFraction(2, 3)}, basicmath.NewFraction(1, 12)},
+		{"NumberLine_TickSpacing_Unsimplified", []*basicmath.Fraction{basicmath.NewFraction(2, 4)}, basicmath.NewFraction(1, 2)},
+		{"NumberLine_TickSpacing_Negative", []*basicmath.Fraction{basicmath.NewFraction(-5, 6)}, basicmath.NewFraction(1, 6)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewNumberLine(tt.values...).TickSpacing(); !got.Equals(tt.want) {
+				t.Errorf("TickSpacing() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumberLine_Range(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []*basicmath.Fraction
+		wantStart *basicmath.Fraction
+		wantEnd   *basicmath.Fraction
+	}{
+		{"NumberLine_Range_Empty", nil, basicmath.NewInteger(0), basicmath.NewInteger(1)},
+		{"NumberLine_Range_ProperFractions", []*basicmath.Fraction{basicmath.NewFraction(1, 4), basicmath.NewFraction(2, 3)}, basicmath.NewInteger(0), basicmath.NewInteger(1)},
+		{"NumberLine_Range_Negative", []*basicmath.Fraction{basicmath.NewFraction(-3, 2), basicmath.NewFraction(1, 3)}, basicmath.NewInteger(-2), basicmath.NewInteger(1)},
+		{"NumberLine_Range_Integer", []*basicmath.Fraction{basicmath.NewInteger(2)}, basicmath.NewInteger(2), basicmath.NewInteger(3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := NewNumberLine(tt.values...).Range()
+			if !start.Equals(tt.wantStart) || !end.Equals(tt.wantEnd) {
+				t.Errorf("Range() = %v, %v, want %v, %v", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestNumberLine_Ticks(t *testing.T) {
+	line := NewNumberLine(basicmath.NewFraction(1, 2))
+	if err := line.SetRange(basicmath.NewInteger(0), basicmath.NewFraction(3, 2)); err != nil {
+		t.Fatalf("SetRange() error = %v", err)
+	}
+
+	ticks, err := line.Ticks()
+	if err != nil {
+		t.Fatalf("Ticks() error = %v", err)
+	}
+
+	want := []string{"0/2", "1/2", "2/2", "3/2"}
+	if len(ticks) != len(want) {
+		t.Fatalf("Ticks() = %v, want %v", ticks, want)
+	}
+	for i, tick := range ticks {
+		if tick.String() != want[i] {
+			t.Errorf("Ticks()[%d] = %v, want %v", i, tick, want[i])
+		}
+	}
+}
+
+func TestNumberLine_Errors(t *testing.T) {
+	line := NewNumberLine(basicmath.NewInteger(5))
+	if err := line.SetRange(basicmath.NewInteger(1), basicmath.NewInteger(1)); err != ErrInvalidRange {
+		t.Errorf("SetRange(1, 1) error = %v, want %v", err, ErrInvalidRange)
+	}
+
+	if err := line.SetRange(basicmath.NewInteger(0), basicmath.NewInteger(2)); err != nil {
+		t.Fatalf("SetRange(0, 2) error = %v", err)
+	}
+	if _, err := line.Ticks(); err != ErrOutOfRange {
+		t.Errorf("Ticks() error = %v, want %v", err, ErrOutOfRange)
+	}
+
+	crowded := NewNumberLine(basicmath.NewFraction(1, 7), basicmath.NewFraction(100, 11))
+	if _, err := crowded.Ticks(); err != ErrTooManyTicks {
+		t.Errorf("Ticks() error = %v, want %v", err, ErrTooManyTicks)
+	}
+}
+
+func TestNumberLine_InvalidPoints(t *testing.T) {
+	line := NewNumberLine()
+	if err := line.Add(nil, Closed); err != ErrInvalidPoint {
+		t.Errorf("Add(nil) error = %v, want %v", err, ErrInvalidPoint)
+	}
+	if err := line.Add(&basicmath.Fraction{}, Open); err != ErrInvalidPoint {
+		t.Errorf("Add(Fraction{}) error = %v, want %v", err, ErrInvalidPoint)
+	}
+	if err := line.SetRange(&basicmath.Fraction{}, basicmath.NewInteger(1)); err != ErrInvalidPoint {
+		t.Errorf("SetRange(Fraction{}, 1) error = %v, want %v", err, ErrInvalidPoint)
+	}
+	if err := line.SetRange(basicmath.NewInteger(0), nil); err != ErrInvalidPoint {
+		t.Errorf("SetRange(0, nil) error = %v, want %v", err, ErrInvalidPoint)
+	}
+	if len(line.Points()) != 0 {
+		t.Errorf("Points() = %v, want none", line.Points())
+	}
+
+	if _, err := NewNumberLineE(basicmath.NewInteger(1), nil); err != ErrInvalidPoint {
+		t.Errorf("NewNumberLineE(1, nil) error = %v, want %v", err, ErrInvalidPoint)
+	}
+}
@@ -0,0 +1,178 @@
+package numberline
+
+import (
+	"fmt"
+	"math"
+	"mymath/basicmath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// TikZLength is the length of the line in centimeters in TikZ output
+	TikZLength = 12.0
+	// SVGWidth and SVGHeight are the size of the SVG image in pixels
+	SVGWidth  = 640
+	SVGHeight = 120
+)
+
+const (
+	svgMargin    = 40.0
+	svgLineY     = 70.0
+	svgFontSize  = 14
+	svgDotRadius = 5
+)
+
+// #region Public Methods
+
+// TikZ draws the number line as a tikzpicture with \dfrac labels, filled dots for closed points and
+// hollow dots for open ones
+func (l *NumberLine) TikZ() (string, error) {
+	ticks, err := l.Ticks()
+	if err != nil {
+		return "", err
+	}
+
+	lines := []string{
+		`\begin{tikzpicture}`,
+		fmt.Sprintf(`\draw[<->] (-0.5,0) -- (%s,0);`, coordinate(TikZLength+0.5)),
+	}
+
+	for _, tick := range ticks {
+		x := coordinate(l.position(tick, TikZLength))
+		if label, major := l.tickLabel(tick); major {
+			lines = append(lines, fmt.Sprintf(`\draw (%s,0.2) -- (%s,-0.2) node[below] {$%s$};`, x, x, label))
+		} else if label != "" {
+			lines = append(lines, fmt.Sprintf(`\draw (%s,0.1) -- (%s,-0.1) node[below] {$%s$};`, x, x, label))
+		} else {
+			lines = append(lines, fmt.Sprintf(`\draw (%s,0.1) -- (%s,-0.1);`, x, x))
+		}
+	}
+
+	for _, point := range l.points {
+		style := `\filldraw`
+		if point.Marker == Open {
+			style = `\filldraw[fill=white]`
+		}
+		lines = append(lines, fmt.Sprintf(`%s (%s,0) circle (2pt) node[above=2pt] {$%s$};`,
+			style, coordinate(l.position(point.Value, TikZLength)), point.Value.LaTeX()))
+	}
+
+	lines = append(lines, `\end{tikzpicture}`)
+	return strings.Join(lines, "\n"), nil
+}
+
+// SVG draws the number line as a standalone SVG image, writing fractions stacked over a bar
+func (l *NumberLine) SVG() (string, error) {
+	ticks, err := l.Ticks()
+	if err != nil {
+		return "", err
+	}
+
+	length := SVGWidth - 2*svgMargin
+	elements := []string{
+		fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="serif" font-size="%d" text-anchor="middle">`,
+			SVGWidth, SVGHeight, SVGWidth, SVGHeight, svgFontSize),
+		svgLine(svgMargin-20, svgLineY, svgMargin+length+20, svgLineY),
+		svgArrowHead(svgMargin-20, -1),
+		svgArrowHead(svgMargin+length+20, 1),
+	}
+
+	for _, tick := range ticks {
+		x := svgMargin + l.position(tick, length)
+		label, major := l.tickLabel(tick)
+
+		size := 5.0
+		if major {
+			size = 8.0
+		}
+		elements = append(elements, svgLine(x, svgLineY-size, x, svgLineY+size))
+		if label != "" {
+			elements = append(elements, svgValue(x, svgLineY+28, tickValue(tick))...)
+		}
+	}
+
+	for _, point := range l.points {
+		x := svgMargin + l.position(point.Value, length)
+		fill := "black"
+		if point.Marker == Open {
+			fill = "white"
+		}
+		elements = append(elements,
+			fmt.Sprintf(`<circle cx="%s" cy="%s" r="%d" fill="%s" stroke="black" stroke-width="1.5"/>`, coordinate(x), coordinate(svgLineY), svgDotRadius, fill))
+		elements = append(elements, svgValue(x, svgLineY-32, point.Value)...)
+	}
+
+	elements = append(elements, "</svg>")
+	return strings.Join(elements, "\n"), nil
+}
+
+// #endregion
+
+// #region Private Methods
+
+// the LaTeX label of a tick, if it has one, and whether it is a whole number
+func (l *NumberLine) tickLabel(tick *basicmath.Fraction) (string, bool) {
+	if tick.Numerator()%tick.Denominator() == 0 {
+		return strconv.Itoa(tick.Numerator() / tick.Denominator()), true
+	}
+	if l.labelTicks {
+		return tick.LaTeX(), false
+	}
+	return "", false
+}
+
+// a tick in lowest terms when it is a whole number, otherwise over the common denominator
+func tickValue(tick *basicmath.Fraction) *basicmath.Fraction {
+	if tick.Numerator()%tick.Denominator() == 0 {
+		return basicmath.NewInteger(tick.Numerator() / tick.Denominator())
+	}
+	return tick
+}
+
+// formats a coordinate with at most three decimal places
+func coordinate(value float64) string {
+	return strconv.FormatFloat(math.Round(value*1000)/1000, 'f', -1, 64)
+}
+
+func svgLine(x1, y1, x2, y2 float64) string {
+	return fmt.Sprintf(`<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="black" stroke-width="1.5"/>`,
+		coordinate(x1), coordinate(y1), coordinate(x2), coordinate(y2))
+}
+
+// a triangle at x pointing left (direction -1) or right (direction 1)
+func svgArrowHead(x float64, direction float64) string {
+	tip := x + direction*8
+	return fmt.Sprintf(`<polygon points="%s,%s %s,%s %s,%s" fill="black"/>`,
+		coordinate(tip), coordinate(svgLineY), coordinate(x), coordinate(svgLineY-5), coordinate(x), coordinate(svgLineY+5))
+}
+
+// writes a value centered at x around y: whole numbers as text and fractions stacked over a bar
+func svgValue(x, y float64, value *basicmath.Fraction) []string {
+	n, d := value.Numerator(), value.Denominator()
+	if d < 0 {
+		n, d = -n, -d
+	}
+	if d == 1 {
+		return []string{svgText(x, y+5, strings.Replace(strconv.Itoa(n), "-", "−", 1))}
+	}
+
+	numerator, denominator := strconv.Itoa(basicmath.Abs(n)), strconv.Itoa(d)
+	halfWidth := float64(basicmath.Max(len(numerator), len(denominator)))*4 + 2
+
+	elements := []string{
+		svgText(x, y-4, numerator),
+		svgLine(x-halfWidth, y, x+halfWidth, y),
+		svgText(x, y+14, denominator),
+	}
+	if n < 0 {
+		elements = append(elements, svgText(x-halfWidth-6, y+5, "−"))
+	}
+	return elements
+}
+
+func svgText(x, y float64, text string) string {
+	return fmt.Sprintf(`<text x="%s" y="%s">%s</text>`, coordinate(x), coordinate(y), text)
+}
+
+// #endregion
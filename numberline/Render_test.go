@@ -0,0 +1,80 @@
+package numberline
+
+import (
+	"mymath/basicmath"
+	"strings"
+	"testing"
+)
+
+func TestNumberLine_TikZ(t *testing.T) {
+	line := NewNumberLine(basicmath.NewFraction(1, 4))
+	line.Add(basicmath.NewFraction(3, 4), Open)
+	line.SetLabelTicks(true)
+
+	got, err := line.TikZ()
+	if err != nil {
+		t.Fatalf("TikZ() error = %v", err)
+	}
+
+	want := strings.Join([]string{
+		`\begin{tikzpicture}`,
+		`\draw[<->] (-0.5,0) -- (12.5,0);`,
+		`\draw (0,0.2) -- (0,-0.2) node[below] {$0$};`,
+		`\draw (3,0.1) -- (3,-0.1) node[below] {$\dfrac{1}{4}$};`,
+		`\draw (6,0.1) -- (6,-0.1) node[below] {$\dfrac{2}{4}$};`,
+		`\draw (9,0.1) -- (9,-0.1) node[below] {$\dfrac{3}{4}$};`,
+		`\draw (12,0.2) -- (12,-0.2) node[below] {$1$};`,
+		`\filldraw (3,0) circle (2pt) node[above=2pt] {$\dfrac{1}{4}$};`,
+		`\filldraw[fill=white] (9,0) circle (2pt) node[above=2pt] {$\dfrac{3}{4}$};`,
+		`\end{tikzpicture}`,
+	}, "\n")
+	if got != want {
+		t.Errorf("TikZ() =\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestNumberLine_TikZ_UnlabeledTicks(t *testing.T) {
+	got, err := NewNumberLine(basicmath.NewFraction(1, 2)).TikZ()
+	if err != nil {
+		t.Fatalf("TikZ() error = %v", err)
+	}
+	if !strings.Contains(got, `\draw (6,0.1) -- (6,-0.1);`) {
+		t.Errorf("TikZ() = %v, want an unlabeled tick at 1/2", got)
+	}
+}
+
+func TestNumberLine_SVG(t *testing.T) {
+	line := NewNumberLine(basicmath.NewFraction(-1, 2))
+	line.Add(basicmath.NewFraction(1, 2), Open)
+
+	got, err := line.SVG()
+	if err != nil {
+		t.Fatalf("SVG() error = %v", err)
+	}
+
+	for _, want := range []string{
+		`<svg xmlns="http://www.w3.org/2000/svg" width="640" height="120"`,
+		`<circle cx="180" cy="70" r="5" fill="black"`,
+		`<circle cx="460" cy="70" r="5" fill="white"`,
+		`<text x="40" y="103">−1</text>`,
+		`<text x="168" y="43">−</text>`,
+		"</svg>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("SVG() does not contain %q:\n%v", want, got)
+		}
+	}
+}
+
+func TestNumberLine_Render_OutOfRange(t *testing.T) {
+	line := NewNumberLine(basicmath.NewInteger(3))
+	if err := line.SetRange(basicmath.NewInteger(0), basicmath.NewInteger(1)); err != nil {
+		t.Fatalf("SetRange() error = %v", err)
+	}
+	if _, err := line.TikZ(); err != ErrOutOfRange {
+		t.Errorf("TikZ() error = %v, want %v", err, ErrOutOfRange)
+	}
+	if _, err := line.SVG(); err != ErrOutOfRange {
+		t.Errorf("SVG() error = %v, want %v", err, ErrOutOfRange)
+	}
+}
@@ -0,0 +1,117 @@
+package sequences
+
+import (
+	"fmt"
+	"mymath/algebra"
+	"mymath/basicmath"
+)
+
+// ArithmeticSequence adds a common difference d to each term: a_n = a_1 + (n - 1)d
+type ArithmeticSequence struct {
+	first      *basicmath.Fraction
+	difference *basicmath.Fraction
+}
+
+// #region Constructor
+
+func NewArithmeticSequence(first *basicmath.Fraction, difference *basicmath.Fraction) *ArithmeticSequence {
+	return &ArithmeticSequence{first: simplified(first), difference: simplified(difference)}
+}
+
+// #endregion
+
+// #region Properties
+
+func (s *ArithmeticSequence) First() *basicmath.Fraction {
+	return simplified(s.first)
+}
+
+func (s *ArithmeticSequence) Difference() *basicmath.Fraction {
+	return simplified(s.difference)
+}
+
+// #endregion
+
+// #region Public Methods
+
+func (s *ArithmeticSequence) Term(n int) (*basicmath.Fraction, error) {
+	if n < 1 {
+		return nil, ErrInvalidIndex
+	}
+
+	step, err := s.difference.TryMultiply(basicmath.NewInteger(n - 1))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.first.TryAdd(step)
+}
+
+// PartialSum uses S_n = n(a_1 + a_n) / 2
+func (s *ArithmeticSequence) PartialSum(n int) (*basicmath.Fraction, error) {
+	if n < 1 {
+		return nil, ErrInvalidIndex
+	}
+
+	last, err := s.Term(n)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.first.TryAdd(last)
+	if err != nil {
+		return nil, err
+	}
+
+	return total.TryMultiply(basicmath.NewFraction(n, 2))
+}
+
+// Formula returns the explicit formula a_n = dn + (a_1 - d) as a polynomial in n;
+// it returns ErrOverflow when a_1 - d does not fit in an int
+func (s *ArithmeticSequence) Formula() (*algebra.Polynomial, error) {
+	constant, err := s.first.TrySubtract(s.difference)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.formula(constant), nil
+}
+
+// LaTeX renders the explicit formula, e.g. a_n = 3n + 2
+func (s *ArithmeticSequence) LaTeX() string {
+	return fmt.Sprintf("a_n = %s", s.formula(basicmath.SubtractRationals(s.first, s.difference)).LaTeX())
+}
+
+func (s *ArithmeticSequence) String() string {
+	return fmt.Sprintf("a_n = %s", s.formula(basicmath.SubtractRationals(s.first, s.difference)))
+}
+
+// #endregion
+
+// #region Private Methods
+
+// builds dn + constant, where the constant may have grown past an int
+func (s *ArithmeticSequence) formula(constant basicmath.Rational) *algebra.Polynomial {
+	monomials := []*algebra.Monomial{}
+	if s.difference.Numerator() != 0 {
+		monomials = append(monomials, algebra.NewMonomial(s.difference, "n"))
+	}
+	if !isZero(constant) || len(monomials) == 0 {
+		monomials = append(monomials, algebra.NewMonomialConstant(constant))
+	}
+
+	return algebra.NewPolynomial(monomials...)
+}
+
+// reports whether the rational is zero
+func isZero(r basicmath.Rational) bool {
+	return basicmath.CompareRationals(r, basicmath.NewInteger(0)) == 0
+}
+
+func simplified(f *basicmath.Fraction) *basicmath.Fraction {
+	copy := basicmath.NewFraction(f.Numerator(), f.Denominator())
+	copy.Simplify()
+	return copy
+}
+
+// #endregion
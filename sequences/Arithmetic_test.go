@@ -0,0 +1,95 @@
+package sequences
+
+import (
+	"errors"
+	"math"
+	"mymath/basicmath"
+	"testing"
+)
+
+func TestArithmeticSequence_Term(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence *ArithmeticSequence
+		n        int
+		want     *basicmath.Fraction
+	}{
+		{"ArithmeticSequence_Term_First", NewArithmeticSequence(basicmath.NewInteger(5), basicmath.NewInteger(3)), 1, basicmath.NewInteger(5)},
+		{"ArithmeticSequence_Term_Tenth", NewArithmeticSequence(basicmath.NewInteger(5), basicmath.NewInteger(3)), 10, basicmath.NewInteger(32)},
+		{"ArithmeticSequence_Term_Fractions", NewArithmeticSequence(basicmath.NewFraction(1, 2), basicmath.NewFraction(-1, 3)), 4, basicmath.NewFraction(-1, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sequence.Term(tt.n)
+			if err != nil {
+				t.Fatalf("Term() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("Term() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArithmeticSequence_Term_InvalidIndex(t *testing.T) {
+	sequence := NewArithmeticSequence(basicmath.NewInteger(1), basicmath.NewInteger(1))
+	if _, err := sequence.Term(0); err != ErrInvalidIndex {
+		t.Errorf("Term(0) error = %v, want %v", err, ErrInvalidIndex)
+	}
+}
+
+func TestArithmeticSequence_PartialSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence *ArithmeticSequence
+		n        int
+		want     *basicmath.Fraction
+	}{
+		{"ArithmeticSequence_PartialSum_Gauss", NewArithmeticSequence(basicmath.NewInteger(1), basicmath.NewInteger(1)), 100, basicmath.NewInteger(5050)},
+		{"ArithmeticSequence_PartialSum_Odd", NewArithmeticSequence(basicmath.NewInteger(1), basicmath.NewInteger(2)), 7, basicmath.NewInteger(49)},
+		{"ArithmeticSequence_PartialSum_Fractions", NewArithmeticSequence(basicmath.NewFraction(1, 2), basicmath.NewFraction(1, 3)), 3, basicmath.NewFraction(5, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sequence.PartialSum(tt.n)
+			if err != nil {
+				t.Fatalf("PartialSum() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("PartialSum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArithmeticSequence_LaTeX(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence *ArithmeticSequence
+		want     string
+	}{
+		{"ArithmeticSequence_LaTeX_Integers", NewArithmeticSequence(basicmath.NewInteger(5), basicmath.NewInteger(3)), "a_n = 3n + 2"},
+		{"ArithmeticSequence_LaTeX_NoConstant", NewArithmeticSequence(basicmath.NewInteger(4), basicmath.NewInteger(4)), "a_n = 4n"},
+		{"ArithmeticSequence_LaTeX_Constant", NewArithmeticSequence(basicmath.NewInteger(7), basicmath.NewInteger(0)), "a_n = 7"},
+		{"ArithmeticSequence_LaTeX_Fraction", NewArithmeticSequence(basicmath.NewInteger(2), basicmath.NewFraction(-1, 2)), `a_n = -\dfrac{1}{2}n + \dfrac{5}{2}`},
+		{"ArithmeticSequence_LaTeX_LargeConstant", NewArithmeticSequence(basicmath.NewInteger(math.MinInt), basicmath.NewInteger(1)), "a_n = n - 9223372036854775809"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sequence.LaTeX(); got != tt.want {
+				t.Errorf("LaTeX() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArithmeticSequence_Formula(t *testing.T) {
+	got, err := NewArithmeticSequence(basicmath.NewInteger(5), basicmath.NewInteger(3)).Formula()
+	if err != nil || got.String() != "3n + 2" {
+		t.Errorf("Formula() = %v, %v, want 3n + 2", got, err)
+	}
+
+	if _, err := NewArithmeticSequence(basicmath.NewInteger(math.MinInt), basicmath.NewInteger(1)).Formula(); !errors.Is(err, basicmath.ErrOverflow) {
+		t.Errorf("Formula() error = %v, want %v", err, basicmath.ErrOverflow)
+	}
+}
@@ -0,0 +1,146 @@
+package sequences
+
+import (
+	"fmt"
+	"mymath/basicmath"
+	"mymath/latex"
+)
+
+// GeometricSequence multiplies each term by a common ratio r: a_n = a_1 · r^(n - 1)
+type GeometricSequence struct {
+	first *basicmath.Fraction
+	ratio *basicmath.Fraction
+}
+
+// #region Constructor
+
+func NewGeometricSequence(first *basicmath.Fraction, ratio *basicmath.Fraction) *GeometricSequence {
+	return &GeometricSequence{first: simplified(first), ratio: simplified(ratio)}
+}
+
+// #endregion
+
+// #region Properties
+
+func (s *GeometricSequence) First() *basicmath.Fraction {
+	return simplified(s.first)
+}
+
+func (s *GeometricSequence) Ratio() *basicmath.Fraction {
+	return simplified(s.ratio)
+}
+
+// #endregion
+
+// #region Public Methods
+
+// Term uses a_n = a_1 · r^(n-1); a ratio of 1 or -1 just repeats or alternates the first term
+func (s *GeometricSequence) Term(n int) (*basicmath.Fraction, error) {
+	if n < 1 {
+		return nil, ErrInvalidIndex
+	}
+
+	switch {
+	case s.ratio.Equals(basicmath.NewInteger(1)):
+		return simplified(s.first), nil
+	case s.ratio.Equals(basicmath.NewInteger(-1)):
+		if n%2 == 0 {
+			return s.first.TryMultiply(s.ratio)
+		}
+		return simplified(s.first), nil
+	}
+
+	power, err := s.ratio.TryPow(n - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.first.TryMultiply(power)
+}
+
+// PartialSum uses S_n = a_1(1 - r^n) / (1 - r), or n · a_1 when r = 1; when r = -1 the terms cancel
+// in pairs, leaving a_1 for odd n and 0 for even n
+func (s *GeometricSequence) PartialSum(n int) (*basicmath.Fraction, error) {
+	if n < 1 {
+		return nil, ErrInvalidIndex
+	}
+
+	one := basicmath.NewInteger(1)
+	switch {
+	case s.ratio.Equals(one):
+		return s.first.TryMultiply(basicmath.NewInteger(n))
+	case s.ratio.Equals(basicmath.NewInteger(-1)):
+		if n%2 == 0 {
+			return basicmath.NewInteger(0), nil
+		}
+		return simplified(s.first), nil
+	}
+
+	power, err := s.ratio.TryPow(n)
+	if err != nil {
+		return nil, err
+	}
+	numerator, err := one.TrySubtract(power)
+	if err != nil {
+		return nil, err
+	}
+	denominator, err := one.TrySubtract(s.ratio)
+	if err != nil {
+		return nil, err
+	}
+	scaled, err := s.first.TryMultiply(numerator)
+	if err != nil {
+		return nil, err
+	}
+
+	return scaled.TryDivide(denominator)
+}
+
+// InfiniteSum returns a_1 / (1 - r) when |r| < 1, or ErrDiverges otherwise
+func (s *GeometricSequence) InfiniteSum() (*basicmath.Fraction, error) {
+	if s.first.Numerator() == 0 {
+		return basicmath.NewInteger(0), nil
+	}
+	if s.ratio.Abs().GreaterThanOrEqualTo(basicmath.NewInteger(1)) {
+		return nil, ErrDiverges
+	}
+
+	denominator, err := basicmath.NewInteger(1).TrySubtract(s.ratio)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.first.TryDivide(denominator)
+}
+
+// LaTeX renders the explicit formula, e.g. a_n = 3 \cdot 2^{n - 1}
+func (s *GeometricSequence) LaTeX() string {
+	base := s.ratio.LaTeX()
+	if !s.ratio.IsInteger() || s.ratio.Numerator() < 0 {
+		base = latex.WrapInParentheses(base)
+	}
+
+	power := fmt.Sprintf("%s^{n - 1}", base)
+	if s.first.Equals(basicmath.NewInteger(1)) {
+		return fmt.Sprintf("a_n = %s", power)
+	}
+
+	return fmt.Sprintf(`a_n = %s \cdot %s`, s.first.LaTeX(), power)
+}
+
+// String renders the explicit formula, e.g. a_n = 3 · 2^(n - 1)
+func (s *GeometricSequence) String() string {
+	base := s.ratio.String()
+	if !s.ratio.IsInteger() || s.ratio.Numerator() < 0 {
+		base = fmt.Sprintf("(%s)", base)
+	}
+
+	power := fmt.Sprintf("%s^(n - 1)", base)
+	if s.first.Equals(basicmath.NewInteger(1)) {
+		return fmt.Sprintf("a_n = %s", power)
+	}
+
+	return fmt.Sprintf("a_n = %s · %s", s.first, power)
+}
+
+// #endregion
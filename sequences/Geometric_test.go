@@ -0,0 +1,113 @@
+package sequences
+
+import (
+	"math"
+	"mymath/basicmath"
+	"testing"
+)
+
+func TestGeometricSequence_Term(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence *GeometricSequence
+		n        int
+		want     *basicmath.Fraction
+	}{
+		{"GeometricSequence_Term_First", NewGeometricSequence(basicmath.NewInteger(3), basicmath.NewInteger(2)), 1, basicmath.NewInteger(3)},
+		{"GeometricSequence_Term_Fifth", NewGeometricSequence(basicmath.NewInteger(3), basicmath.NewInteger(2)), 5, basicmath.NewInteger(48)},
+		{"GeometricSequence_Term_Half", NewGeometricSequence(basicmath.NewInteger(8), basicmath.NewFraction(1, 2)), 5, basicmath.NewFraction(1, 2)},
+		{"GeometricSequence_Term_Alternating", NewGeometricSequence(basicmath.NewInteger(1), basicmath.NewInteger(-3)), 4, basicmath.NewInteger(-27)},
+		{"GeometricSequence_Term_RatioOneLargeN", NewGeometricSequence(basicmath.NewInteger(5), basicmath.NewInteger(1)), math.MaxInt, basicmath.NewInteger(5)},
+		{"GeometricSequence_Term_RatioMinusOneOdd", NewGeometricSequence(basicmath.NewInteger(5), basicmath.NewInteger(-1)), 1<<62 + 1, basicmath.NewInteger(5)},
+		{"GeometricSequence_Term_RatioMinusOneEven", NewGeometricSequence(basicmath.NewInteger(5), basicmath.NewInteger(-1)), 1 << 62, basicmath.NewInteger(-5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sequence.Term(tt.n)
+			if err != nil {
+				t.Fatalf("Term() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("Term() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometricSequence_PartialSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence *GeometricSequence
+		n        int
+		want     *basicmath.Fraction
+	}{
+		{"GeometricSequence_PartialSum_Doubling", NewGeometricSequence(basicmath.NewInteger(1), basicmath.NewInteger(2)), 10, basicmath.NewInteger(1023)},
+		{"GeometricSequence_PartialSum_Half", NewGeometricSequence(basicmath.NewInteger(1), basicmath.NewFraction(1, 2)), 4, basicmath.NewFraction(15, 8)},
+		{"GeometricSequence_PartialSum_RatioOne", NewGeometricSequence(basicmath.NewFraction(2, 3), basicmath.NewInteger(1)), 6, basicmath.NewInteger(4)},
+		{"GeometricSequence_PartialSum_RatioMinusOneOdd", NewGeometricSequence(basicmath.NewInteger(5), basicmath.NewInteger(-1)), 1<<62 + 1, basicmath.NewInteger(5)},
+		{"GeometricSequence_PartialSum_RatioMinusOneEven", NewGeometricSequence(basicmath.NewInteger(5), basicmath.NewInteger(-1)), 1 << 62, basicmath.NewInteger(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sequence.PartialSum(tt.n)
+			if err != nil {
+				t.Fatalf("PartialSum() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("PartialSum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometricSequence_InfiniteSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence *GeometricSequence
+		want     *basicmath.Fraction
+		wantErr  error
+	}{
+		{"GeometricSequence_InfiniteSum_Half", NewGeometricSequence(basicmath.NewInteger(1), basicmath.NewFraction(1, 2)), basicmath.NewInteger(2), nil},
+		{"GeometricSequence_InfiniteSum_NegativeRatio", NewGeometricSequence(basicmath.NewInteger(9), basicmath.NewFraction(-1, 3)), basicmath.NewFraction(27, 4), nil},
+		{"GeometricSequence_InfiniteSum_Diverges", NewGeometricSequence(basicmath.NewInteger(1), basicmath.NewInteger(2)), nil, ErrDiverges},
+		{"GeometricSequence_InfiniteSum_RatioMinusOne", NewGeometricSequence(basicmath.NewInteger(1), basicmath.NewInteger(-1)), nil, ErrDiverges},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sequence.InfiniteSum()
+			if err != tt.wantErr {
+				t.Fatalf("InfiniteSum() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want != nil && !got.Equals(tt.want) {
+				t.Errorf("InfiniteSum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometricSequence_LaTeX(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence *GeometricSequence
+		want     string
+	}{
+		{"GeometricSequence_LaTeX_Integers", NewGeometricSequence(basicmath.NewInteger(3), basicmath.NewInteger(2)), `a_n = 3 \cdot 2^{n - 1}`},
+		{"GeometricSequence_LaTeX_FirstOne", NewGeometricSequence(basicmath.NewInteger(1), basicmath.NewInteger(5)), `a_n = 5^{n - 1}`},
+		{"GeometricSequence_LaTeX_Fraction", NewGeometricSequence(basicmath.NewInteger(8), basicmath.NewFraction(1, 2)), `a_n = 8 \cdot \left(\dfrac{1}{2}\right)^{n - 1}`},
+		{"GeometricSequence_LaTeX_Negative", NewGeometricSequence(basicmath.NewInteger(2), basicmath.NewInteger(-3)), `a_n = 2 \cdot \left(-3\right)^{n - 1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sequence.LaTeX(); got != tt.want {
+				t.Errorf("LaTeX() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometricSequence_String(t *testing.T) {
+	sequence := NewGeometricSequence(basicmath.NewInteger(3), basicmath.NewFraction(1, 2))
+	if got, want := sequence.String(), "a_n = 3 · (1/2)^(n - 1)"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+}
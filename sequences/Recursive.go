@@ -0,0 +1,98 @@
+package sequences
+
+import (
+	"fmt"
+	"mymath/basicmath"
+	"strings"
+)
+
+// RecurrenceRule computes the next term from a copy of every term before it, oldest first
+type RecurrenceRule func(previous []*basicmath.Fraction) (*basicmath.Fraction, error)
+
+// RecursiveSequence starts from fixed initial terms and builds each later term with a rule,
+// e.g. the Fibonacci numbers start 1, 1 and add the two previous terms
+type RecursiveSequence struct {
+	rule        RecurrenceRule
+	description string
+	initial     int
+	terms       []*basicmath.Fraction
+}
+
+// #region Constructor
+
+// NewRecursiveSequence builds a sequence from a rule; description is the recurrence in LaTeX,
+// e.g. a_n = a_{n-1} + a_{n-2}
+func NewRecursiveSequence(rule RecurrenceRule, description string, initial ...*basicmath.Fraction) *RecursiveSequence {
+	terms := make([]*basicmath.Fraction, len(initial))
+	for i, term := range initial {
+		terms[i] = simplified(term)
+	}
+
+	return &RecursiveSequence{rule: rule, description: description, initial: len(terms), terms: terms}
+}
+
+// #endregion
+
+// #region Public Methods
+
+// Term computes the terms up to a_n, remembering them for later calls
+func (s *RecursiveSequence) Term(n int) (*basicmath.Fraction, error) {
+	if n < 1 {
+		return nil, ErrInvalidIndex
+	}
+	if len(s.terms) == 0 {
+		return nil, ErrNoInitialTerms
+	}
+
+	for len(s.terms) < n {
+		// the rule gets a copy so it cannot change the remembered terms
+		previous := make([]*basicmath.Fraction, len(s.terms))
+		for i, term := range s.terms {
+			previous[i] = simplified(term)
+		}
+
+		next, err := s.rule(previous)
+		if err != nil {
+			return nil, err
+		}
+		s.terms = append(s.terms, simplified(next))
+	}
+
+	return simplified(s.terms[n-1]), nil
+}
+
+func (s *RecursiveSequence) PartialSum(n int) (*basicmath.Fraction, error) {
+	if _, err := s.Term(n); err != nil {
+		return nil, err
+	}
+
+	return basicmath.NewInteger(0).TryAdd(s.terms[:n]...)
+}
+
+// LaTeX renders the recurrence followed by the initial terms, e.g. a_n = a_{n-1} + a_{n-2},\ a_1 = 1,\ a_2 = 1
+func (s *RecursiveSequence) LaTeX() string {
+	parts := []string{s.description}
+	for i, term := range s.initialTerms() {
+		parts = append(parts, fmt.Sprintf("a_{%d} = %s", i+1, term.LaTeX()))
+	}
+	return strings.Join(parts, `,\ `)
+}
+
+func (s *RecursiveSequence) String() string {
+	parts := []string{s.description}
+	for i, term := range s.initialTerms() {
+		parts = append(parts, fmt.Sprintf("a_%d = %s", i+1, term))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// #endregion
+
+// #region Private Methods
+
+// the terms passed to the constructor, before any were computed by the rule
+func (s *RecursiveSequence) initialTerms() []*basicmath.Fraction {
+	return s.terms[:s.initial]
+}
+
+// #endregion
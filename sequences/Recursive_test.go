@@ -0,0 +1,89 @@
+package sequences
+
+import (
+	"mymath/basicmath"
+	"testing"
+)
+
+func fibonacci() *RecursiveSequence {
+	rule := func(previous []*basicmath.Fraction) (*basicmath.Fraction, error) {
+		return previous[len(previous)-1].TryAdd(previous[len(previous)-2])
+	}
+	return NewRecursiveSequence(rule, "a_n = a_{n-1} + a_{n-2}", basicmath.NewInteger(1), basicmath.NewInteger(1))
+}
+
+func TestRecursiveSequence_Term(t *testing.T) {
+	halving := NewRecursiveSequence(func(previous []*basicmath.Fraction) (*basicmath.Fraction, error) {
+		return previous[len(previous)-1].TryAdd(basicmath.NewFraction(1, 2))
+	}, `a_n = a_{n-1} + \dfrac{1}{2}`, basicmath.NewInteger(0))
+
+	tests := []struct {
+		name     string
+		sequence *RecursiveSequence
+		n        int
+		want     *basicmath.Fraction
+	}{
+		{"RecursiveSequence_Term_Initial", fibonacci(), 2, basicmath.NewInteger(1)},
+		{"RecursiveSequence_Term_Fibonacci", fibonacci(), 10, basicmath.NewInteger(55)},
+		{"RecursiveSequence_Term_Fractions", halving, 4, basicmath.NewFraction(3, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sequence.Term(tt.n)
+			if err != nil {
+				t.Fatalf("Term() error = %v", err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("Term() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecursiveSequence_PartialSum(t *testing.T) {
+	sequence := fibonacci()
+
+	// ask for a later term first so PartialSum must only read the cached terms it needs
+	if _, err := sequence.Term(12); err != nil {
+		t.Fatalf("Term() error = %v", err)
+	}
+
+	got, err := sequence.PartialSum(10)
+	if err != nil {
+		t.Fatalf("PartialSum() error = %v", err)
+	}
+	if !got.Equals(basicmath.NewInteger(143)) {
+		t.Errorf("PartialSum() = %v, want 143", got)
+	}
+}
+
+func TestRecursiveSequence_NoInitialTerms(t *testing.T) {
+	sequence := NewRecursiveSequence(func(previous []*basicmath.Fraction) (*basicmath.Fraction, error) {
+		return previous[0], nil
+	}, "a_n = a_{n-1}")
+
+	if _, err := sequence.Term(1); err != ErrNoInitialTerms {
+		t.Errorf("Term() error = %v, want %v", err, ErrNoInitialTerms)
+	}
+}
+
+func TestRecursiveSequence_RuleCannotChangeTerms(t *testing.T) {
+	sequence := NewRecursiveSequence(func(previous []*basicmath.Fraction) (*basicmath.Fraction, error) {
+		previous[0] = basicmath.NewInteger(100)
+		return previous[len(previous)-1].TryAdd(basicmath.NewInteger(1))
+	}, "a_n = a_{n-1} + 1", basicmath.NewInteger(1))
+
+	if _, err := sequence.Term(3); err != nil {
+		t.Fatalf("Term() error = %v", err)
+	}
+	if got, _ := sequence.Term(1); !got.Equals(basicmath.NewInteger(1)) {
+		t.Errorf("Term(1) = %v after the rule changed its input, want 1", got)
+	}
+}
+
+func TestRecursiveSequence_LaTeX(t *testing.T) {
+	want := `a_n = a_{n-1} + a_{n-2},\ a_{1} = 1,\ a_{2} = 1`
+	if got := fibonacci().LaTeX(); got != want {
+		t.Errorf("LaTeX() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,101 @@
+package sequences
+
+import (
+	"errors"
+	"mymath/basicmath"
+)
+
+var (
+	// ErrDiverges is returned for the infinite sum of a geometric series with |r| >= 1
+	ErrDiverges = errors.New("sequences: series diverges")
+	// ErrNoInitialTerms is returned when a recursive sequence has nothing to build from
+	ErrNoInitialTerms = errors.New("sequences: a recursive sequence needs at least one initial term")
+	// ErrInvalidIndex is returned when a term index is less than 1
+	ErrInvalidIndex = errors.New("sequences: term index must be at least 1")
+	// ErrTooFewTerms is returned when there are not enough terms to recognize a sequence
+	ErrTooFewTerms = errors.New("sequences: need at least three terms")
+	// ErrUnrecognized is returned when terms are neither arithmetic nor geometric
+	ErrUnrecognized = errors.New("sequences: terms are neither arithmetic nor geometric")
+)
+
+// Sequence is an infinite list of terms a_1, a_2, a_3, ... Terms are numbered from 1.
+type Sequence interface {
+	// Term returns a_n
+	Term(n int) (*basicmath.Fraction, error)
+
+	// PartialSum returns a_1 + ... + a_n
+	PartialSum(n int) (*basicmath.Fraction, error)
+
+	LaTeX() string
+
+	String() string
+}
+
+// #region Public Methods
+
+// Terms returns a_1 through a_count
+func Terms(s Sequence, count int) ([]*basicmath.Fraction, error) {
+	terms := make([]*basicmath.Fraction, 0, count)
+	for n := 1; n <= count; n++ {
+		term, err := s.Term(n)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+// Recognize finds the arithmetic or geometric sequence that starts with the given terms.
+// A constant sequence is reported as arithmetic with a common difference of 0.
+func Recognize(terms []*basicmath.Fraction) (Sequence, error) {
+	if len(terms) < 3 {
+		return nil, ErrTooFewTerms
+	}
+
+	difference, err := terms[1].TrySubtract(terms[0])
+	if err != nil {
+		return nil, err
+	}
+	if hasCommonDifference(terms, difference) {
+		return NewArithmeticSequence(terms[0], difference), nil
+	}
+
+	if terms[0].Numerator() != 0 {
+		ratio, err := terms[1].TryDivide(terms[0])
+		if err != nil {
+			return nil, err
+		}
+		if ratio.Numerator() != 0 && hasCommonRatio(terms, ratio) {
+			return NewGeometricSequence(terms[0], ratio), nil
+		}
+	}
+
+	return nil, ErrUnrecognized
+}
+
+// #endregion
+
+// #region Private Methods
+
+func hasCommonDifference(terms []*basicmath.Fraction, difference *basicmath.Fraction) bool {
+	for i := 1; i < len(terms); i++ {
+		d, err := terms[i].TrySubtract(terms[i-1])
+		if err != nil || !d.Equals(difference) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasCommonRatio(terms []*basicmath.Fraction, ratio *basicmath.Fraction) bool {
+	for i := 1; i < len(terms); i++ {
+		expected, err := terms[i-1].TryMultiply(ratio)
+		if err != nil || !expected.Equals(terms[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// #endregion
@@ -0,0 +1,53 @@
+package sequences
+
+import (
+	"mymath/basicmath"
+	"testing"
+)
+
+func fractions(values ...*basicmath.Fraction) []*basicmath.Fraction {
+	return values
+}
+
+func TestRecognize(t *testing.T) {
+	tests := []struct {
+		name    string
+		terms   []*basicmath.Fraction
+		want    string
+		wantErr error
+	}{
+		{"Recognize_Arithmetic", fractions(basicmath.NewInteger(2), basicmath.NewInteger(5), basicmath.NewInteger(8), basicmath.NewInteger(11)), "a_n = 3n - 1", nil},
+		{"Recognize_ArithmeticFractions", fractions(basicmath.NewFraction(1, 2), basicmath.NewInteger(1), basicmath.NewFraction(3, 2)), `a_n = \dfrac{1}{2}n`, nil},
+		{"Recognize_Constant", fractions(basicmath.NewInteger(4), basicmath.NewInteger(4), basicmath.NewInteger(4)), "a_n = 4", nil},
+		{"Recognize_Geometric", fractions(basicmath.NewInteger(3), basicmath.NewInteger(6), basicmath.NewInteger(12), basicmath.NewInteger(24)), `a_n = 3 \cdot 2^{n - 1}`, nil},
+		{"Recognize_GeometricFractions", fractions(basicmath.NewInteger(27), basicmath.NewInteger(-9), basicmath.NewInteger(3), basicmath.NewInteger(-1)), `a_n = 27 \cdot \left(-\dfrac{1}{3}\right)^{n - 1}`, nil},
+		{"Recognize_Squares", fractions(basicmath.NewInteger(1), basicmath.NewInteger(4), basicmath.NewInteger(9), basicmath.NewInteger(16)), "", ErrUnrecognized},
+		{"Recognize_StartsWithZero", fractions(basicmath.NewInteger(0), basicmath.NewInteger(1), basicmath.NewInteger(3)), "", ErrUnrecognized},
+		{"Recognize_TooFew", fractions(basicmath.NewInteger(1), basicmath.NewInteger(2)), "", ErrTooFewTerms},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Recognize(tt.terms)
+			if err != tt.wantErr {
+				t.Fatalf("Recognize() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.LaTeX() != tt.want {
+				t.Errorf("Recognize() = %v, want %v", got.LaTeX(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTerms(t *testing.T) {
+	got, err := Terms(NewGeometricSequence(basicmath.NewInteger(1), basicmath.NewInteger(3)), 4)
+	if err != nil {
+		t.Fatalf("Terms() error = %v", err)
+	}
+
+	want := []int{1, 3, 9, 27}
+	for i, term := range got {
+		if !term.Equals(basicmath.NewInteger(want[i])) {
+			t.Errorf("Terms()[%d] = %v, want %v", i, term, want[i])
+		}
+	}
+}